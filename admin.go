@@ -0,0 +1,254 @@
+package gobay
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"net/url"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const adminTokenKey = "admin_token"
+
+// ExtensionState is the state of an extension in the app lifecycle
+type ExtensionState string
+
+const (
+	ExtensionPending     ExtensionState = "pending"
+	ExtensionInitialized ExtensionState = "initialized"
+	ExtensionFailed      ExtensionState = "failed"
+	ExtensionClosed      ExtensionState = "closed"
+)
+
+// An AdminAction is an operation offered by the admin server, e.g. flushing
+// cache keys. params are the query and form values of the request, and the
+// result is returned as JSON.
+type AdminAction func(ctx context.Context, params url.Values) (interface{}, error)
+
+// AdminActionProvider is implemented by extensions which offer admin
+// actions. The actions are named <extension key>.<name>.
+type AdminActionProvider interface {
+	AdminActions() map[string]AdminAction
+}
+
+// RegisterAdminAction registers an admin action of the project, it replaces
+// the action registered with the same name.
+func (d *Application) RegisterAdminAction(name string, action AdminAction) {
+	d.adminMu.Lock()
+	defer d.adminMu.Unlock()
+	if d.adminActions == nil {
+		d.adminActions = make(map[string]AdminAction)
+	}
+	d.adminActions[name] = action
+}
+
+// AdminActions returns the actions registered by RegisterAdminAction and
+// offered by extensions
+func (d *Application) AdminActions() map[string]AdminAction {
+	actions := make(map[string]AdminAction)
+	for key, ext := range d.extensions {
+		if provider, ok := ext.(AdminActionProvider); ok {
+			for name, action := range provider.AdminActions() {
+				actions[string(key)+"."+name] = action
+			}
+		}
+	}
+	d.adminMu.Lock()
+	defer d.adminMu.Unlock()
+	for name, action := range d.adminActions {
+		actions[name] = action
+	}
+	return actions
+}
+
+// ExtensionStates returns the state of every extension
+func (d *Application) ExtensionStates() map[Key]ExtensionState {
+	d.statesMu.Lock()
+	defer d.statesMu.Unlock()
+	states := make(map[Key]ExtensionState, len(d.extensions))
+	for key := range d.extensions {
+		state, ok := d.extStates[key]
+		if !ok {
+			state = ExtensionPending
+		}
+		states[key] = state
+	}
+	return states
+}
+
+func (d *Application) setExtensionState(key Key, state ExtensionState) {
+	d.statesMu.Lock()
+	defer d.statesMu.Unlock()
+	if d.extStates == nil {
+		d.extStates = make(map[Key]ExtensionState)
+	}
+	d.extStates[key] = state
+}
+
+// AdminHandler serves the admin endpoints, every request must carry the
+// admin_token by the "Authorization: Bearer <token>" header:
+//
+//	GET  /extensions       extensions and their states
+//	GET  /config           the effective config, secrets are redacted
+//	GET  /config/explain   the effective config with sources
+//	GET  /buildinfo        build info of the binary
+//	GET  /actions          names of the admin actions
+//	POST /actions/{name}   run an admin action
+//	     /debug/pprof/     pprof
+//	     /debug/vars       expvar
+func (d *Application) AdminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /extensions", d.adminExtensions)
+	mux.HandleFunc("GET /config", func(w http.ResponseWriter, r *http.Request) {
+		writeAdminJSON(w, http.StatusOK, d.RedactedConfig())
+	})
+	mux.HandleFunc("GET /config/explain", func(w http.ResponseWriter, r *http.Request) {
+		writeAdminJSON(w, http.StatusOK, d.ExplainConfig())
+	})
+	mux.HandleFunc("GET /buildinfo", adminBuildInfo)
+	mux.HandleFunc("GET /actions", func(w http.ResponseWriter, r *http.Request) {
+		names := make([]string, 0)
+		for name := range d.AdminActions() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		writeAdminJSON(w, http.StatusOK, names)
+	})
+	mux.HandleFunc("POST /actions/{name}", d.adminRunAction)
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return d.adminAuth(mux)
+}
+
+func (d *Application) adminAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := d.Config().GetString(adminTokenKey)
+		// the token is never read from the query, which is written to the
+		// access logs
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+			writeAdminJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid admin token"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type adminExtension struct {
+	Key       Key            `json:"key"`
+	Type      string         `json:"type"`
+	State     ExtensionState `json:"state"`
+	DependsOn []Key          `json:"depends_on,omitempty"`
+}
+
+func (d *Application) adminExtensions(w http.ResponseWriter, r *http.Request) {
+	states := d.ExtensionStates()
+	exts := make([]adminExtension, 0, len(d.extensions))
+	for key, ext := range d.extensions {
+		item := adminExtension{Key: key, Type: reflect.TypeOf(ext).String(), State: states[key]}
+		if dependent, ok := ext.(Dependent); ok {
+			item.DependsOn = dependent.DependsOn()
+		}
+		exts = append(exts, item)
+	}
+	sort.Slice(exts, func(i, j int) bool { return exts[i].Key < exts[j].Key })
+	writeAdminJSON(w, http.StatusOK, exts)
+}
+
+func adminBuildInfo(w http.ResponseWriter, r *http.Request) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		writeAdminJSON(w, http.StatusNotFound, map[string]string{"error": "no build info"})
+		return
+	}
+	settings := make(map[string]string, len(info.Settings))
+	for _, setting := range info.Settings {
+		settings[setting.Key] = setting.Value
+	}
+	deps := make(map[string]string, len(info.Deps))
+	for _, dep := range info.Deps {
+		deps[dep.Path] = dep.Version
+	}
+	writeAdminJSON(w, http.StatusOK, map[string]interface{}{
+		"go_version": info.GoVersion,
+		"path":       info.Path,
+		"main":       info.Main.Path + "@" + info.Main.Version,
+		"settings":   settings,
+		"deps":       deps,
+	})
+}
+
+func (d *Application) adminRunAction(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	action, ok := d.AdminActions()[name]
+	if !ok {
+		writeAdminJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("unknown admin action %s", name)})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeAdminJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	result, err := action(r.Context(), r.Form)
+	if err != nil {
+		writeAdminJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	d.Logger().Info("admin action done", "action", name)
+	writeAdminJSON(w, http.StatusOK, map[string]interface{}{"result": result})
+}
+
+func writeAdminJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// startAdminServer serves AdminHandler on admin_listen_host:admin_listen_port
+// if admin_listen_port is set
+func (d *Application) startAdminServer() error {
+	config := d.Config()
+	port := config.GetInt("admin_listen_port")
+	if port == 0 {
+		return nil
+	}
+	if config.GetString(adminTokenKey) == "" {
+		return errors.New("admin_token is required by the admin server")
+	}
+	addr := net.JoinHostPort(config.GetString("admin_listen_host"), strconv.Itoa(port))
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	d.adminServer = &http.Server{Handler: d.AdminHandler()}
+	go func(srv *http.Server) {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			d.Logger().Error("admin server stopped", "error", err)
+		}
+	}(d.adminServer)
+	d.Logger().Info("admin server started", "addr", lis.Addr().String())
+	return nil
+}
+
+func (d *Application) stopAdminServer(ctx context.Context) error {
+	if d.adminServer == nil {
+		return nil
+	}
+	err := d.adminServer.Shutdown(ctx)
+	d.adminServer = nil
+	return err
+}
@@ -0,0 +1,151 @@
+package gobay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type adminExtension_ struct {
+	orderExtension
+	flushed []string
+}
+
+func (e *adminExtension_) AdminActions() map[string]AdminAction {
+	return map[string]AdminAction{
+		"flush": func(ctx context.Context, params url.Values) (interface{}, error) {
+			e.flushed = append(e.flushed, params["prefix"]...)
+			e.flushed = append(e.flushed, params["token"]...)
+			return len(e.flushed), nil
+		},
+	}
+}
+
+func adminRequest(t *testing.T, handler http.Handler, method, target, token string) (int, string) {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code, rec.Body.String()
+}
+
+func TestAdminHandler(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	cache := &adminExtension_{orderExtension: orderExtension{key: "cache", events: &events}}
+	db := &orderExtension{key: "db", deps: []Key{"cache"}, events: &events}
+	app, err := CreateAppFromMap(map[string]interface{}{
+		"admin_token": "s3cret",
+		"db_password": "${base64:cGFzc3dvcmQ=}",
+		"db_host":     "localhost",
+	}, map[Key]Extension{"cache": cache, "db": db})
+	assert.Nil(err)
+	app.RegisterAdminAction("fail", func(context.Context, url.Values) (interface{}, error) {
+		return nil, errors.New("failed")
+	})
+	handler := app.AdminHandler()
+
+	code, _ := adminRequest(t, handler, http.MethodGet, "/extensions", "")
+	assert.Equal(http.StatusUnauthorized, code)
+	code, _ = adminRequest(t, handler, http.MethodGet, "/extensions", "wrong")
+	assert.Equal(http.StatusUnauthorized, code)
+
+	code, body := adminRequest(t, handler, http.MethodGet, "/extensions", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	var exts []adminExtension
+	assert.Nil(json.Unmarshal([]byte(body), &exts))
+	assert.Equal([]adminExtension{
+		{Key: "cache", Type: "*gobay.adminExtension_", State: ExtensionInitialized},
+		{Key: "db", Type: "*gobay.orderExtension", State: ExtensionInitialized, DependsOn: []Key{"cache"}},
+	}, exts)
+
+	code, _ = adminRequest(t, handler, http.MethodGet, "/config?token=s3cret", "")
+	assert.Equal(http.StatusUnauthorized, code)
+	code, body = adminRequest(t, handler, http.MethodGet, "/config", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	var config map[string]interface{}
+	assert.Nil(json.Unmarshal([]byte(body), &config))
+	assert.Equal(RedactedValue, config["admin_token"])
+	assert.Equal(RedactedValue, config["db_password"])
+	assert.Equal("localhost", config["db_host"])
+
+	code, body = adminRequest(t, handler, http.MethodGet, "/config/explain", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, `{"key":"db_host","value":"localhost","source":{"kind":"map"}}`)
+	assert.Contains(body, `{"key":"db_password","value":"******","source":{"kind":"map"},"redacted":true}`)
+
+	code, body = adminRequest(t, handler, http.MethodGet, "/buildinfo", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, "go_version")
+
+	code, body = adminRequest(t, handler, http.MethodGet, "/actions", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.JSONEq(`["cache.flush", "fail"]`, body)
+
+	code, body = adminRequest(t, handler, http.MethodPost, "/actions/cache.flush?prefix=user", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.JSONEq(`{"result": 1}`, body)
+	assert.Equal([]string{"user"}, cache.flushed)
+	// a param named token is passed to the action like the others
+	code, _ = adminRequest(t, handler, http.MethodPost, "/actions/cache.flush?token=t1", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.Equal([]string{"user", "t1"}, cache.flushed)
+	code, body = adminRequest(t, handler, http.MethodPost, "/actions/fail", "s3cret")
+	assert.Equal(http.StatusInternalServerError, code)
+	assert.JSONEq(`{"error": "failed"}`, body)
+	code, _ = adminRequest(t, handler, http.MethodPost, "/actions/unknown", "s3cret")
+	assert.Equal(http.StatusNotFound, code)
+
+	code, body = adminRequest(t, handler, http.MethodGet, "/debug/pprof/", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, "goroutine")
+	code, body = adminRequest(t, handler, http.MethodGet, "/debug/vars", "s3cret")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, "memstats")
+
+	assert.Nil(app.Close())
+	assert.Equal(map[Key]ExtensionState{"cache": ExtensionClosed, "db": ExtensionClosed}, app.ExtensionStates())
+}
+
+func TestAdminServerRequiresToken(t *testing.T) {
+	assert := assert.New(t)
+	app, err := CreateAppFromMap(map[string]interface{}{
+		"admin_listen_host": "127.0.0.1",
+		"admin_listen_port": freePort(t),
+	}, map[Key]Extension{})
+	assert.Nil(err)
+	assert.EqualError(app.Start(), "start admin server failed: admin_token is required by the admin server")
+}
+
+type failingExtension struct {
+	orderExtension
+}
+
+func (e *failingExtension) Init(app *Application) error {
+	return errors.New("init failed")
+}
+
+func TestExtensionStates(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	app := &Application{configMap: map[string]interface{}{}, extensions: map[Key]Extension{
+		"ok":      &orderExtension{key: "ok", events: &events},
+		"failed":  &failingExtension{orderExtension{key: "failed", events: &events}},
+		"skipped": &orderExtension{key: "skipped", deps: []Key{"failed"}, events: &events},
+	}}
+	assert.Equal(ExtensionPending, app.ExtensionStates()["ok"])
+	assert.Error(app.Init())
+	assert.Equal(map[Key]ExtensionState{
+		"ok":      ExtensionInitialized,
+		"failed":  ExtensionFailed,
+		"skipped": ExtensionFailed,
+	}, app.ExtensionStates())
+}
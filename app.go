@@ -1,18 +1,25 @@
 package gobay
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
 	"os"
-	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/shanbay/gobay/observability"
 	"github.com/spf13/viper"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
 // A Key represents a key for a Extension.
@@ -26,16 +33,49 @@ type Extension interface {
 	Close() error
 }
 
+// Dependent is implemented by extensions which must be initialized after
+// other extensions. DependsOn returns the keys of those extensions.
+type Dependent interface {
+	DependsOn() []Key
+}
+
 // Application struct
 type Application struct {
-	rootPath    string
-	env         string
-	config      *viper.Viper
-	extensions  map[Key]Extension
-	initialized bool
-	closed      bool
-	mu          sync.Mutex
-	shutdown    func(context.Context) error
+	rootPath      string
+	fsys          fs.FS
+	configData    []byte
+	configMap     map[string]interface{}
+	env           string
+	config        *viper.Viper
+	configMu      sync.RWMutex
+	secrets       map[string]bool
+	sources       configSources
+	overrides     map[string]interface{}
+	extensions    map[Key]Extension
+	order         []Key
+	hooksMu       sync.Mutex
+	startHooks    []Hook
+	closeHooks    []Hook
+	initialized   bool
+	started       bool
+	closed        bool
+	mu            sync.Mutex
+	shutdown      func(context.Context) error
+	logger        *slog.Logger
+	logLevel      slog.LevelVar
+	logFile       *os.File
+	registry      *prometheus.Registry
+	registryOnce  sync.Once
+	metricsServer *http.Server
+	meterProvider *sdkmetric.MeterProvider
+	running       atomic.Bool
+	liveness      healthCache
+	readiness     healthCache
+	adminMu       sync.Mutex
+	adminActions  map[string]AdminAction
+	adminServer   *http.Server
+	statesMu      sync.Mutex
+	extStates     map[Key]ExtensionState
 }
 
 // Get the extension at the specified key, return nil when the component doesn't exist
@@ -59,6 +99,8 @@ func (d *Application) Env() string {
 
 // Config returns the viper config for this application
 func (d *Application) Config() *viper.Viper {
+	d.configMu.RLock()
+	defer d.configMu.RUnlock()
 	return d.config
 }
 
@@ -74,92 +116,360 @@ func (d *Application) Init() error {
 	if err := d.initConfig(); err != nil {
 		return err
 	}
-	d.setup()
+	if err := d.setupLogger(); err != nil {
+		return err
+	}
+	order, err := sortExtensions(d.extensions)
+	if err != nil {
+		return err
+	}
+	d.order = order
+	if err := d.setup(); err != nil {
+		return err
+	}
 	if err := d.initExtensions(); err != nil {
 		return err
 	}
+	if err := d.afterInitExtensions(); err != nil {
+		return err
+	}
 	d.initialized = true
+	d.running.Store(true)
 	return nil
 }
 
 func (d *Application) initConfig() error {
-	configfile := filepath.Join(d.rootPath, "config.yaml")
-	originConfig, err := os.ReadFile(configfile)
+	config, secrets, sources, err := d.loadConfig()
 	if err != nil {
 		return err
 	}
-	renderedConfig := []byte(os.ExpandEnv(string(originConfig)))
-	config := viper.New()
-	config.SetConfigType("yaml")
-	if err := config.ReadConfig(bytes.NewBuffer(renderedConfig)); err != nil {
+	if err := d.validateConfig(config, sources); err != nil {
 		return err
 	}
-	config = config.Sub(d.env)
-
-	// add default config
-	config.SetDefault("debug", false)
-	config.SetDefault("testing", false)
-	config.SetDefault("timezone", "UTC")
-	config.SetDefault("grpc_listen_host", "localhost")
-	config.SetDefault("grpc_listen_port", 6000)
-	config.SetDefault("openapi_listen_host", "localhost")
-	config.SetDefault("openapi_listen_port", 3000)
-
-	d.config = config
+	d.setConfig(config, secrets, sources)
+	return nil
+}
 
+func (d *Application) setup() error {
+	cfg, err := d.otelConfig()
+	if err != nil {
+		return err
+	}
+	// the prometheus exporter of the provider is registered into the app
+	// registry, create it once even if Init is called again after failing
+	if d.meterProvider == nil {
+		mp, err := observability.NewMeterProvider(d.Registry(), cfg)
+		if err != nil {
+			return fmt.Errorf("create meter provider failed: %w", err)
+		}
+		d.meterProvider = mp
+	}
+	shutdownTracing, err := observability.InitializeWithConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("setup observability failed: %w", err)
+	}
+	d.shutdown = func(ctx context.Context) error {
+		var allerr error
+		if err := d.meterProvider.Shutdown(ctx); err != nil {
+			allerr = multierror.Append(allerr, err)
+		}
+		if err := shutdownTracing(ctx); err != nil {
+			allerr = multierror.Append(allerr, err)
+		}
+		return allerr
+	}
 	return nil
 }
 
-func (d *Application) setup() {
-	d.shutdown = observability.Initialize()
+// otelConfig returns the observability config from the OTEL_* env vars,
+// overridden by the otel_ prefixed config of the app
+func (d *Application) otelConfig() (observability.Config, error) {
+	cfg := observability.ConfigFromEnv()
+	if err := GetConfigByPrefix(d.config, "otel_", true).Unmarshal(&cfg); err != nil {
+		return cfg, fmt.Errorf("invalid otel config: %w", err)
+	}
+	if cfg.Environment == "" {
+		cfg.Environment = d.env
+	}
+	return cfg, nil
 }
 
+// initExtensions init extensions concurrently, every extension starts
+// after its dependencies are initialized. An extension whose dependencies
+// failed is skipped. Each extension is bounded by init_timeout.
 func (d *Application) initExtensions() error {
+	timeout := d.Config().GetDuration("init_timeout")
+	var mu sync.Mutex
+	results := make(map[Key]error, len(d.order))
+	done := make(map[Key]chan struct{}, len(d.order))
+	for _, key := range d.order {
+		done[key] = make(chan struct{})
+	}
+	failed := func(key Key) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return results[key] != nil
+	}
+
+	var wg sync.WaitGroup
+	for _, key := range d.order {
+		wg.Add(1)
+		go func(key Key) {
+			defer wg.Done()
+			defer close(done[key])
+			ext := d.extensions[key]
+			if setter, ok := ext.(LoggerSetter); ok {
+				setter.SetLogger(d.ExtLogger(key))
+			}
+			var err error
+			if dependent, ok := ext.(Dependent); ok {
+				for _, dep := range dependent.DependsOn() {
+					<-done[dep]
+					if failed(dep) {
+						err = fmt.Errorf("dependency %s init failed", dep)
+						break
+					}
+				}
+			}
+			if err == nil {
+				err = d.initExtension(key, ext, timeout)
+			}
+			if err != nil {
+				d.setExtensionState(key, ExtensionFailed)
+			} else {
+				d.setExtensionState(key, ExtensionInitialized)
+			}
+			mu.Lock()
+			results[key] = err
+			mu.Unlock()
+		}(key)
+	}
+	wg.Wait()
+
 	var allerr error
-	for key, ext := range d.extensions {
-		if err := ext.Init(d); err != nil {
+	for _, key := range d.order {
+		if err := results[key]; err != nil {
 			allerr = multierror.Append(allerr, errors.New(string(key)), err)
 		}
 	}
 	return allerr
 }
 
+// initExtension init ext, and gives up waiting after timeout if timeout > 0.
+// Init can not be interrupted, so it keeps running after the timeout, and
+// ext is closed if Init succeeds later, to release what it has opened.
+func (d *Application) initExtension(key Key, ext Extension, timeout time.Duration) error {
+	if timeout <= 0 {
+		return ext.Init(d)
+	}
+	result := make(chan error, 1)
+	go func() {
+		result <- ext.Init(d)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		go func() {
+			if err := <-result; err == nil {
+				if err := ext.Close(); err != nil {
+					d.ExtLogger(key).Error("close extension initialized after timeout failed", "error", err)
+				}
+			}
+		}()
+		return fmt.Errorf("init timed out after %v", timeout)
+	}
+}
+
+// sortExtensions returns the keys of exts in topological order, so that every
+// extension comes after the extensions it depends on. Keys without ordering
+// constraints between them are sorted by name to keep the order stable.
+func sortExtensions(exts map[Key]Extension) ([]Key, error) {
+	keys := make([]Key, 0, len(exts))
+	for key := range exts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[Key]int, len(keys))
+	order := make([]Key, 0, len(keys))
+	var visit func(key Key, path []Key) error
+	visit = func(key Key, path []Key) error {
+		switch state[key] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := make([]string, 0, len(path)+1)
+			for _, k := range path {
+				cycle = append(cycle, string(k))
+			}
+			cycle = append(cycle, string(key))
+			return fmt.Errorf("extension dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+		state[key] = visiting
+		if dependent, ok := exts[key].(Dependent); ok {
+			for _, dep := range dependent.DependsOn() {
+				if _, exist := exts[dep]; !exist {
+					return fmt.Errorf("extension %s depends on unknown extension %s", key, dep)
+				}
+				if err := visit(dep, append(path, key)); err != nil {
+					return err
+				}
+			}
+		}
+		state[key] = visited
+		order = append(order, key)
+		return nil
+	}
+	for _, key := range keys {
+		if err := visit(key, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
 // Close close app when exit
 func (d *Application) Close() error {
+	return d.CloseContext(context.Background())
+}
+
+// CloseContext close app when exit. It always tries to close every
+// extension and shutdown observability, and returns all the errors. Closing
+// extensions which are not finished before ctx is done are reported as errors.
+func (d *Application) CloseContext(ctx context.Context) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
 	if d.closed {
 		return nil
 	}
+	// not ready any more, stop receiving traffic
+	d.running.Store(false)
 
-	if err := d.closeExtensions(); err != nil {
-		return err
+	var allerr error
+	if err := d.beforeClose(ctx); err != nil {
+		allerr = multierror.Append(allerr, err)
 	}
-	err := d.shutdown(context.Background())
-	if err != nil {
-		return err
+	if err := d.closeExtensions(ctx); err != nil {
+		allerr = multierror.Append(allerr, err)
+	}
+	if err := d.stopMetricsServer(ctx); err != nil {
+		allerr = multierror.Append(allerr, errors.New("metrics"), err)
+	}
+	if err := d.stopAdminServer(ctx); err != nil {
+		allerr = multierror.Append(allerr, errors.New("admin"), err)
+	}
+	if d.shutdown != nil {
+		if err := d.shutdown(ctx); err != nil {
+			allerr = multierror.Append(allerr, errors.New("observability"), err)
+		}
+	}
+	if err := d.closeLogger(); err != nil {
+		allerr = multierror.Append(allerr, errors.New("logger"), err)
 	}
 	d.closed = true
-	return nil
+	return allerr
 }
 
-func (d *Application) closeExtensions() error {
-	for _, ext := range d.extensions {
-		if err := ext.Close(); err != nil {
-			return err
+// closeExtensions close extensions in reverse init order
+func (d *Application) closeExtensions(ctx context.Context) error {
+	var allerr error
+	for i := len(d.order) - 1; i >= 0; i-- {
+		key := d.order[i]
+		ext := d.extensions[key]
+		if ctx.Err() != nil {
+			// no time to wait, still give it a chance to release resources
+			go func() { _ = ext.Close() }()
+			allerr = multierror.Append(allerr, errors.New(string(key)), ctx.Err())
+			continue
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- ext.Close()
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				d.setExtensionState(key, ExtensionFailed)
+				allerr = multierror.Append(allerr, errors.New(string(key)), err)
+			} else {
+				d.setExtensionState(key, ExtensionClosed)
+			}
+		case <-ctx.Done():
+			allerr = multierror.Append(allerr, errors.New(string(key)), ctx.Err())
+		}
+	}
+	return allerr
+}
+
+// An Option configures an Application
+type Option func(*Application)
+
+// WithConfigOverrides set config values which take precedence over all
+// config files and environment variables.
+func WithConfigOverrides(overrides map[string]interface{}) Option {
+	return func(app *Application) {
+		if app.overrides == nil {
+			app.overrides = make(map[string]interface{})
+		}
+		for key, value := range overrides {
+			app.overrides[strings.ToLower(key)] = value
 		}
 	}
-	return nil
 }
 
 // CreateApp create an gobay Application
-func CreateApp(rootPath string, env string, exts map[Key]Extension) (*Application, error) {
+func CreateApp(rootPath string, env string, exts map[Key]Extension, opts ...Option) (*Application, error) {
 	if rootPath == "" || env == "" {
 		return nil, fmt.Errorf("lack of rootPath or env")
 	}
 
-	app := &Application{rootPath: rootPath, env: env, extensions: exts}
+	return initApp(&Application{rootPath: rootPath, env: env, extensions: exts}, opts)
+}
+
+// CreateAppFromReader create app with the YAML config read from r, which
+// contains a sub-tree for every env like config.yaml
+func CreateAppFromReader(r io.Reader, env string, exts map[Key]Extension, opts ...Option) (*Application, error) {
+	if r == nil || env == "" {
+		return nil, fmt.Errorf("lack of reader or env")
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return initApp(&Application{configData: data, env: env, extensions: exts}, opts)
+}
+
+// CreateAppFromFS create app with the config files in the root of fsys,
+// e.g. an embed.FS. The files are laid out like the rootPath of CreateApp.
+func CreateAppFromFS(fsys fs.FS, env string, exts map[Key]Extension, opts ...Option) (*Application, error) {
+	if fsys == nil || env == "" {
+		return nil, fmt.Errorf("lack of fsys or env")
+	}
+	return initApp(&Application{fsys: fsys, env: env, extensions: exts}, opts)
+}
+
+// CreateAppFromMap create app with the config of a single env, the map
+// contains the keys of the env directly. The env of the app is empty.
+func CreateAppFromMap(config map[string]interface{}, exts map[Key]Extension, opts ...Option) (*Application, error) {
+	if config == nil {
+		return nil, fmt.Errorf("lack of config")
+	}
+	return initApp(&Application{configMap: config, extensions: exts}, opts)
+}
+
+func initApp(app *Application, opts []Option) (*Application, error) {
+	for _, opt := range opts {
+		opt(app)
+	}
 
 	if err := app.Init(); err != nil {
 		return nil, err
@@ -1,11 +1,17 @@
 package gobay
 
 import (
+	"context"
 	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shanbay/gobay/observability"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -63,25 +69,233 @@ func TestCreateApp(t *testing.T) {
 func TestApplicationClose(t *testing.T) {
 	assert := assert.New(t)
 	testExt := new(testExtension)
+	var events []string
 	exts := map[Key]Extension{
-		"test": testExt,
+		"test":  testExt,
+		"other": &orderExtension{key: "other", events: &events},
 	}
 	testExt.On("Init", mock.Anything).Return(nil)
 	app, _ := CreateApp("./testdata", "testing", exts)
-	// call extension.Close failed
+	// call extension.Close failed, other extensions are still closed
 	closeErr := errors.New("close failed")
-	call := testExt.On("Close", mock.Anything)
-	call.Return(closeErr)
+	testExt.On("Close", mock.Anything).Return(closeErr)
 	err := app.Close()
-	assert.Equal(err, closeErr)
+	assert.Equal(multierror.Append(errors.New("test"), closeErr), err)
 	testExt.AssertNumberOfCalls(t, "Close", 1)
-	// success
-	call.Return(nil)
-	err = app.Close()
-	assert.Nil(err)
-	testExt.AssertNumberOfCalls(t, "Close", 2)
+	assert.Contains(events, "close other")
 	// close again
 	err = app.Close()
 	assert.Nil(err)
-	testExt.AssertNumberOfCalls(t, "Close", 2)
+	testExt.AssertNumberOfCalls(t, "Close", 1)
+}
+
+type slowExtension struct {
+	orderExtension
+	delay time.Duration
+}
+
+func (e *slowExtension) Close() error {
+	time.Sleep(e.delay)
+	return nil
+}
+
+func TestApplicationCloseContext(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	exts := map[Key]Extension{
+		"a":    &orderExtension{key: "a", events: &events},
+		"slow": &slowExtension{orderExtension{key: "slow", deps: []Key{"a"}, events: &events}, time.Second},
+	}
+	app, err := CreateApp("./testdata", "testing", exts)
+	assert.Nil(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = app.CloseContext(ctx)
+	assert.True(time.Since(start) < 500*time.Millisecond)
+	assert.Equal(multierror.Append(
+		errors.New("slow"), context.DeadlineExceeded,
+		errors.New("a"), context.DeadlineExceeded,
+	), err)
+}
+
+// extensions may be initialized concurrently
+var eventsMu sync.Mutex
+
+type orderExtension struct {
+	key    Key
+	deps   []Key
+	events *[]string
+}
+
+func (e *orderExtension) Init(app *Application) error {
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	*e.events = append(*e.events, "init "+string(e.key))
+	return nil
+}
+
+func (e *orderExtension) Close() error {
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+	*e.events = append(*e.events, "close "+string(e.key))
+	return nil
+}
+
+func (e *orderExtension) Application() *Application { return nil }
+
+func (e *orderExtension) Object() interface{} { return e }
+
+func (e *orderExtension) DependsOn() []Key { return e.deps }
+
+func TestExtensionDependencyOrder(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	exts := map[Key]Extension{
+		"a": &orderExtension{key: "a", deps: []Key{"c"}, events: &events},
+		"b": &orderExtension{key: "b", deps: []Key{"a", "c"}, events: &events},
+		"c": &orderExtension{key: "c", events: &events},
+	}
+	app, err := CreateApp("./testdata", "testing", exts)
+	assert.Nil(err)
+	assert.Equal([]string{"init c", "init a", "init b"}, events)
+
+	events = nil
+	assert.Nil(app.Close())
+	assert.Equal([]string{"close b", "close a", "close c"}, events)
+}
+
+func TestExtensionDependencyError(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	// cycle
+	exts := map[Key]Extension{
+		"a": &orderExtension{key: "a", deps: []Key{"b"}, events: &events},
+		"b": &orderExtension{key: "b", deps: []Key{"a"}, events: &events},
+	}
+	app, err := CreateApp("./testdata", "testing", exts)
+	assert.Nil(app)
+	assert.EqualError(err, "extension dependency cycle: a -> b -> a")
+	assert.Empty(events)
+	// unknown dependency
+	exts = map[Key]Extension{
+		"a": &orderExtension{key: "a", deps: []Key{"redis"}, events: &events},
+	}
+	app, err = CreateApp("./testdata", "testing", exts)
+	assert.Nil(app)
+	assert.EqualError(err, "extension a depends on unknown extension redis")
+	// dependency init failed
+	testExt := new(testExtension)
+	testExt.On("Init", mock.Anything).Return(errors.New("init failed"))
+	exts = map[Key]Extension{
+		"a":    &orderExtension{key: "a", deps: []Key{"test"}, events: &events},
+		"test": testExt,
+	}
+	app, err = CreateApp("./testdata", "testing", exts)
+	assert.Nil(app)
+	assert.Contains(err.Error(), "dependency test init failed")
+	assert.Empty(events)
+}
+
+type blockingExtension struct {
+	orderExtension
+	release chan struct{}
+}
+
+func (e *blockingExtension) Init(app *Application) error {
+	<-e.release
+	return e.orderExtension.Init(app)
+}
+
+func TestParallelInit(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	release := make(chan struct{})
+	exts := map[Key]Extension{
+		// a and b are independent, a waits until b is initialized
+		"a": &blockingExtension{orderExtension{key: "a", events: &events}, release},
+		"b": &orderExtension{key: "b", events: &events},
+		"c": &orderExtension{key: "c", deps: []Key{"a", "b"}, events: &events},
+	}
+	go func() {
+		for {
+			eventsMu.Lock()
+			n := len(events)
+			eventsMu.Unlock()
+			if n > 0 {
+				close(release)
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	_, err := CreateApp("./testdata", "testing", exts)
+	assert.Nil(err)
+	assert.Equal([]string{"init b", "init a", "init c"}, events)
+}
+
+func TestInitTimeout(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	release := make(chan struct{})
+	exts := map[Key]Extension{
+		"hung": &blockingExtension{orderExtension{key: "hung", events: &events}, release},
+		"ok":   &orderExtension{key: "ok", events: &events},
+	}
+	start := time.Now()
+	app, err := CreateApp("./testdata", "testing", exts,
+		WithConfigOverrides(map[string]interface{}{"init_timeout": "50ms"}))
+	assert.True(time.Since(start) < time.Second)
+	assert.Nil(app)
+	assert.Equal(multierror.Append(errors.New("hung"), errors.New("init timed out after 50ms")), err)
+
+	// the extension initialized after the timeout is closed
+	close(release)
+	assert.Eventually(func() bool {
+		eventsMu.Lock()
+		defer eventsMu.Unlock()
+		return strings.Contains(strings.Join(events, ","), "close hung")
+	}, time.Second, time.Millisecond)
+}
+
+func TestOtelConfig(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv("OTEL_TRACES_SAMPLER", "always_off")
+	t.Setenv("OTEL_PROPAGATORS", "tracecontext")
+	app := &Application{env: "testing", configMap: map[string]interface{}{
+		"otel_sampler":             "parentbased_traceidratio",
+		"otel_sampler_arg":         0.1,
+		"otel_propagators":         "tracecontext,b3",
+		"otel_headers":             map[string]interface{}{"api-key": "abc"},
+		"otel_resource_attributes": map[string]interface{}{"team": "backend"},
+	}}
+	assert.Nil(app.initConfig())
+	cfg, err := app.otelConfig()
+	assert.Nil(err)
+	assert.Equal("parentbased_traceidratio", cfg.Sampler)
+	assert.Equal(0.1, cfg.SamplerArg)
+	assert.Equal([]string{"tracecontext", "b3"}, cfg.Propagators)
+	assert.Equal(map[string]string{"api-key": "abc"}, cfg.Headers)
+	assert.Equal(map[string]string{"team": "backend"}, cfg.ResourceAttributes)
+	assert.Equal("testing", cfg.Environment)
+
+	// the certificate set by the config of the app enables TLS
+	app = &Application{env: "testing", configMap: map[string]interface{}{
+		"otel_enable":      true,
+		"otel_certificate": "/nonexistent/ca.pem",
+	}}
+	assert.Nil(app.initConfig())
+	cfg, err = app.otelConfig()
+	assert.Nil(err)
+	assert.Nil(cfg.Insecure)
+	_, err = observability.NewMeterProvider(prometheus.NewRegistry(), cfg)
+	assert.ErrorContains(err, "read certificate failed")
+
+	_, err = CreateAppFromMap(map[string]interface{}{
+		"otel_enable":   true,
+		"otel_exporter": "none",
+		"otel_sampler":  "sometimes",
+	}, nil)
+	assert.EqualError(err, "setup observability failed: unknown sampler sometimes")
 }
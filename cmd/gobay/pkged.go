@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd6973a3c8b230fc576ef8ebe969b3084974c4fd20b04020095b20d61b374eb0c808512c23a105dd38fffd8daa028436db3de33e33e77d1c133d16504b56565666565666d6ff3d44e96bb679f8f17f0f61542cb7de773f4b1e374b37f5dcf231cc3cb784df9ea2f5c38f87477db3586f1ef7e9a399ade3c7307bdcacfdaad0b70729c9b375f1e216cb871ff71bfbf6a0b8c90296a81e9f32ffe1c7c3c3b787b9bb0e17c5cd6ef2387c0cdcf53e4affe9ae936e07b7f5dd7df8f6a066d9ed3a6dd0a66ee12f1f7efccfc3f787fffdf6a0152e583cfc28d6db45f5a02edc4d963efc78d8c0a7ff0a16f9220d16a95ffef8afd64096ee6619f9d93a7f5cacd7fbb59b3f7c7b103321028b0d6cdbcdf3ef61063bc098402fbdb2586c1ebe3d2cd6eb6c0d7fbc26c5c3b7879bad86d96fc91614112a7b5e6893bf92f4e32eca17f043943d46d9b688c0c3b7870c369abbc5f2f135020bf8e3e1dbc3a658476908bf6ccad487203d2d720ccff635ca1ebe3570f95992af179bcde32b708b45fb45788c72f49c166e942ed68f20da14d58bc501fd5a977991353f1e5ddc227ef0a37c8980ad9e83f6c760e39e1e16fef96340310cc9b65e0010e545e49fde2c13b7f514a5c5629dbae0d1cbe0a8ef7e78f4bce88daf9b9b1f17c1de5d079b0b906e7e7e7c8d1620b851288d36c5e216c0f8c3e36be41637beaedd34a826f9f2d366eb156071fa9004cce901d66b3df99dd6431bd19ba54b9e3d514cf7ec9921a9d6f345970568cde78121d8f3a713ac89eb6717451ff3383ac04591780b08ea22f5b3004f5dfdf3d1dda464fbd973378b6ee7ec4d94baebb2fdc6dfecda8fcbc5a1fdb8824bbcf59c2f92b38509dcf0d6fa7cdda45911bd96cd8f7bcbf7c4147e76759f0a2d7df0c627f4cfdddc65207591dc5d6f166ff5814badd11cbd576ce3bb69fa8162c5dacfd2dd7bc58a2c5ea46f1582b3f48101a0621f000d95bbd169e286919fa56eb47eccd759be5817116250ed2251e12f17002c1f1337df14ebad5f6cd78bf332f90280059c5338bd4596805b6cdb7d5dacb3bb1f1e134487571ffdab89c6ef577b17148bf57ee116cbc53a71d35b85f28a9aef8a90f687ad976da2227b0cb362816730036e1a7ecfd6e1e3e171536e1eb7295aaf67efa110782cd66eba79cdd6c9cdafdb34f2b360f198d605f238fc1ea58f511a7ddf91ed37a59b80ef3bc86ee0f4557f1efdb54fa37705422cfcf3582c92bc12550d8b71bda8fd08659b0bc2f62b3fdfb61f5f936293ad8bf6ab3073d7fef2fc4db0f0b6e1f9abc5215faca364915e54462cae79c63fcede1499db7e4e1745b176fdb361649b9a3734aff20c80f6f36595f5e2152cfc0244e708d9b8af8b963a707a1fa52158bc82285c9ec1bf2937be0bc0e3e2b0f031095c7daa48a0795f2c3605c8ceb0b34d61bfcb851b545a0a56555e37172a0bae9660d8e09f472c98ab9fc5a6fe5d49b2244a16d59f47c4427317cd1d7af1fb362b1601e265ae870454ba28f0ff1f974591b77ea2ffd5586f5ed6e0df7af7e86efc28aabea48b22aa9b83e49daf33a4fdc0e7edfa4c17bba1925533857e858b43defc78dc9469e142dcaeb76981875afd7af4c3acf57482aac812a40f5d7da9907af57e53226510d3fc89555fe889f8cfa9f96aee1fbe3de0c5ee7afb758445067e715a8b67cf987fc3b77838151f38fd7adc16af64f7fcb98f1e21053d7c7bd82dd220834cb5c5526ac572e9fa4b97223e562acf4049d204f34e69f407f28d8f96abf593b70a6fd7bb45ad387ea8dc69be6a55f28d6acb38787dbb449b8b7c0009d7dae5adc290da837403ff258bcdc60ddf2ad8acba708b56f5bbe5f2757628df29483d2e73d78fdf281505a9fbc6e775b6bd3bd1888e370b7fbb5e3c7a5110adb77731712500ef16aaa91c36f89172485efeefb787f962539c6f30ff09d96eb5cb845f5b3bcd4691fd59ed33718b22850536bf4339425f6807c57a51f8cb3562f8500d76379bc5fa5233b92c94646882d0631a4268a7590051f9e3ff1edeb7104cdd28adf7e71fb13c88d9340bde2ef51866df932c40858dc57a13a1ed3ef99dec3ffceb5ffffaf6f08a917cdf0af2e3d14f82aaad9acfa10a51fa9ac1bfc1a27023805ea5d8c2712af6ed61131d170f3fe87ee7db430259e10f8aecf43afd0ed921d19b7f2256f9e3812228fa37a2ff1b45cec9ce0fa6f383ee7ca77b1dbadb27fadd7f10fd1f04647cd1e69f01c40b461164ee3ffeefe169b17bf841767bbd1e451308e10bf8dceff5be3d28204ae3871f24f5ed414ab3871f24d12158bafbed418f82871f0c417e7b10e12f8af8f6a006b021e2dbc38b1bfcd30fb37f120f3ffe87f886fefbdf6f0f0308e9265ff8b04f0dfe21bb2cd525887eaff3ed41d9c0377da64b9204dba7fff5ed61fa81f2cd08fff5ed81ffc9f25cb42e96d7751896a2bb3443f4eb3ac4bfbe3d68a759e040e6c71b344e0ec4787a3a04dbfdf6200037c41fc4458afe4e36b9bb5ea09fb3ea2744c8ff22ca592ed6ff7fb290dd05eb6dc399b78d40f05fd2d37f25d126416dbd671b4bb700d47629fcfb27d81daef077e629952208618bc30f4df74d06f3af6f0f815bb8f58821f1a5c5a9d5536dd4e54f31afc7ef615444619aad176ff3b156b98691b10d23eb409e71cec188df88ee6f646f4e923f48f247a77fc5b75e5db0798771d134d9ef9f18579b6ff588cfe25b9d0ec3f45858b9e22b24c1740992bdc3b76ef2945bfcea46bb2cc9d2ddce1fe3576c8b5ff5ff5dfcea26395ef0b013f9b58b5cf3ab13173ab11a4cd0154ba908e29ca7b459082efd51e6717af5ff1cc768afea86793c48e574250d8dd8b5d49d9f4ec77cc4e48e682cf988a17c4ad939a23e1e474cee997bf8f718d083311f4d5752c98dbc92231c93dc7ba24038b36c250de5dc4b84d2358da3243a3b3fe2f67ec2a67e22147ec92dbd6416fae2817428b0f5f7d9ef93c4e838b3eaafd581edc79e88fbf1d07795f14410f11133d449761e886ce9ccb2b15472ba63298424e2fe16da3eb45323f746b330a000e1f25ce850fd30108d63c00f0a9b1ac0360958671ca2fa2f81a5ee036b167a941d06e2124862b0934465172446699b019046eacea3f61b69a4ec1d53c99d04ac6cf3b059681ced8ac6561a1947c76cfa9bba960a345d15f828db7ab441f021c207e99a8718be43f089b3dfe16f4704c780cf201e435534f68ec9c48ec9ac161a17bb960c6c5addf909007e39c8fcc4283cda81f0648ec6ad3c8a2c1c938163079ec8d2104e49045b9b3a908ea887ae35cb61bb41626c1d912d277b34de27d8869f08a9a371a563713b3f9d8d6d8aa51c4b5e3a94be0d464b820ffffbbf1f3e5d94a0cd8c1f7d2f13f09e346917ad050a0555d3bb0285fc8de8fc46d173b2f783eafc20a84f1628ddfe2f132804dbefb23443dc51844996ecb2fd0ef507040ac1525497bd2f506eb6ad9d90fd2550be04ca07054a7bc1b6650a77744c3d74472ae18fa6dd49c9a6aea56681296d6d8a2d2654b0f34461eb504a7ef6db3ce45e3a5bd914bb7512900696bc0c44b0f3c26ceca7c6f639cc4269a4e69e69ec026bd6959e94828f0698cf8fa65d497496de4801f09d4f15b9cf93909f1e513d7e104e342e75ccce984f603f2af0d3691796754c66692707d02ee751a0f045b60c46d3317c27f144e899e0e8d31c8032aa7ee7506c1298e43e18b4eb3ac5f9b352ba969adbfbecf3f96bbe5efce667491215bff959fa1a85df4bf75d4e7ba752cd733b5de20d9e7bc30c41537487623f91fb767a24d3ed43f5f6d7d8216896a1890edb233f6c87a886f8613bc4a9fc3d367ca30ed923987eaf61c7681ebed8f1173bfe183bbeb3aacf18f3fc8231effd442f2053f64c404c1265e7695ce2d152e8996ce9685cee25cece4fc89a11af2441018ec665c148ddfbc76c37a1b9d2d148a498bad6ac62f0cb9d4715c749b2243c731f3a095b4a22b9f3133d744576e7d2d3b1632d57dec8889d79164aa903bc046e402ac69c304b8f87b0b184574a633f31f61e8d99b5546225d8a33a900917d24806bed8ef4ae2920846dcf139eaef1ca8948f8c088fe7628ce7f016353c88a18f6410acb23058cdb60adfd9a3fe782ef32876ed1fb38ae923a69e3b4f59188ce4a50b8556e21701b5cca120f24561e5cc30f347f089207e8e38e025b3c2a3ecc249c0c6d1c8c4b596c00fafcaad5cd158b925c9d826b9a9c69b7afb0c091b848763f64705ebc518875b653edd4e2b4155e101e3b8126eae39ebc28dd1cd76d03f6ee927c1f139e2bc091e53f02b3611533746a7d6df8b247f47b49d17ad055a8fe8bc6596a27f23a8df086a4ed23f18f607437eef920c41b1fdfe671ba8baec6709b47eb74791fd2e590b8f1e4dd30c4b92ec1d81d66368b2c7b04df966847704da5be57f7e5f81f15f4b32b2fb25cabe44d95ba2ec7c15b704d81e0a2075e75187231f4dc77c1cbc484f500bef8f558215e7c62c7c6934f07c342714197f6737b6c9a43615179e08b6f09b569575add9581db24f1a6168a8acc8ae5cca2879c06973527d999503561a71a547ab3b7b9f8d2b188067713b1f599f90d5a682479575c0f2860ea62a3f60259e8902136c9c413ed40cf9551d82b981dfc7aea51055795d35141df63d81c28f9e8df96896a943a059a42ae8e42c7f0e33d9338bd8b5a470620d42995fa2f68ca13137f8f8549e900d4d3f0cc7f36ccc9be4da114129f1e45ee22fbecfb271dd068629eef221285c4acdfd6850f8fc201e0f116c43cd5074581e8d7ba86c1c53d806d6003e8f278093e604e340c1e589c612eed46cea006cb303db2b3d6d50f8891d5ef435e62db99078b274a2a69f0a6737bf21fc343088c1c6a3e4a5c77389270aa9bfcf56c8da260a7b9fe722d764967e1a871e6d10fec8202491597aa63e9ec5e0597aeab3a8dc0059c596fe68107aa6907b1177f468a3b4293d44027a96f39acebceaa4fc84eb2885c3b3856d82ed240976b83f6cdd9bd068cee02e92722d79e72524f0d2591888420ae973160b8e4518cf4634787cd1b8d8b11ce089ecde338d6d50c39104b5c5344556554b21200e02b3134265eccc9a99185b9b0ac73a30f078f8ce7846b0ca5c179e557ef0f8326b70d1d4c775f1b36dc969604218d5a3c42f310ecb783c33e4915e56f547dcd2a68aa543194764611cc9a41761b826940cbc44c93c5328dd7d3ed3083f7c3912e124ea6f275167cc47cc4c1bb2cfe63cc35659517d7544230a4cbf79f61303d2d1d1d1104c633e76e4f9d0785587066fe8c12b9e2ba2a19b1930e4f970964fca5906bf8d67b9a8e907c12265613e34389d30aeea54b4b693f925afe99d1cce191f4d4323314a9f023b2fc216e95a319444b075923ea26b95604dd590e79aced66d1e5dd1d878685d042f638da31c4b3eba26bb9546fbd0a60d385fb1059543d33e8e67f9d39c2015cc6306f178a4648e79d8a07548007d8cacb076e18b42e9537aa80d0d4ee507e54bc4c68e65efbc14f6c5f5a591ba74cd7d3831a7e1746ee77c2c6b339d79d2100f99653eb5049eb887f0417e9623e52e22f7b6251f1d6d504c4c21b253f4ae744cfb4e3ff1d8880d4d27c0cb3cbad3aea8029f524ad79481c413458068e6ba1d3e0189630dc3313fcb304dc2b11e24551720cf91eb7710a75ec26e9d590ebc910224f156bd7dce87a085476eeb510ce091651b247c98e74e621c243e8cc7c31a2ff1068f311ef37a0b5722b3f3127dec9807487337eb21f880cccd63453a832f517327ccc6aee9005f1b6432bf6c68a382f18c562eebcd87aa381f0a706d755f34cee7c3b83079aee22572699bccca7d22dab084d62093274658f126a6deacb032bfacf1914bc27ecc6ba42d89e4d2353bdba0a6eb15118f0547d08122cf89ce55398762cd9a765f34488f275a873cb65e836a7ce054721a3eaf8870621e6227392c1d7acacae52c3b8d294e259e4c6dcad9d86670947879e9897b76a277c289b98ff830d754fd20cc0c45b008673ad383b9f494b1124f6e1c11d779d1fc222807855fc2b5751856fde6f23e1b5ff08fee0d3926431a9d9771c55b6668fdabba6ca8245bc3184ecc3e7c8f7906a10a33608c2c4296e7511c62fe80793d1c3fb234461c96db021b3ba64c3afb4c9e44fd783cbce65363b8be87cacb5c27b9793ccb218c9897c6e144837c16c9bfd19c9039d3608539b073b886fc84dd05909f08b2318fe0dac1f2c4a2650065a94fe9adf1aa32d403f830aef90f860fd76f78608d3b892777a86e0ca6aac1b6e6e0f6986b9d06ca31db64e2f64918d26b5a3cbd9225abe64409d20cee07c969dc07e6236d1da6e1dd1a3e89ab79fe0cd31fa7123ae6956d99afb1a77e3486588c66e8d4cc4984dc6bc3703ad50a0393891d4b0a17a330f44528d3a19ccd9fe6a423e8b130520d569ce9606a91c6b33a843a5f9f959ea67b3ecc2bf85b748ad70e0565b16d2a40e2c9c486784f04248bfd27e25095a96021778ea8b3b6a5ee3c13acd037acd3752ff1c18700195a7c5158b9141a43ee458358e6975007d8b84807e8ec265107c918879681cf0f8a204256ecfcb9e462af44fc44ae4ef212d73c80174dbac0611fb6096c6a0969226ef507e54b29f1fd70a2c55bdc86143d576dd6ebaad23be2b100e156db3486c79c72a54739b927eaac14cde2362c523428aae7bd4bf9ec2462a1ce85c7c44bb1dce8197a248d0e7d49343aae350ba7f3c2e74310237e1f82d4a3203d92c04f02704d57590d7bf88274c62bba82b21f9dfafaf8e4762f89c3d04ee55d402bc08f38c22b39ca350dfafc045806e80460909fe8aca6ff41d6c20fbb6b68311a14ee482d3cc4cf6fc1d16acb60112d3d577a556b6d131ec56e6a5820cd0526b36aade7132d6b71f8ca738463ea977b825d8593ea6417b4f90f8bc600f59d5ad7143902c222f1c4e10abf14866392aa9d00ea24a24242da77843da481641ad57444c6121f78cfa9b2876be519907d59f3217cabc09a851373b645e54da823e1793ce106f22e419e0bac318f554187fc309ac239e31d281f4572e9279bcb3d47d4fa16fa2379877477835df923bdfd5c3826d17e8e3cb8c72b917702a2538b56f1fa86eb75064eef2961e309ec31108532e0b91bef59c8372ab9224fe78432b1484e9b1370ee6ff233dc37ddac19ec2d50f3429233dea9bff2474603c72461c96050f74f0a73839de9318b9edbba704d139e69140d1e346617c0bd2fa629c53054c114dea96f1984632d6ff74faada4c578477e027fc4458d57ddf1eb7f1aa0fc1f056ff36cd912df8f7ae79825fd5c9575d905faae799a6cf6ef55f382679823fe57267706ffede802339ec6caab8d30e691843c3fe483b57f86cb77385cffbed0423796953fad61741cc8739af423d5260aff4ce00eeed11feeb7de412ad0da46f5a780d209db092e77a2c3ca92d9a1a57ed5c8ebf55879f0fd9278dbc51a73d77f3167f3ca7f95a5f3d9ba756fbe7f3ac55bab249928168746eb53f370c5d359637eaf4d9462724b16cc3fa0984376ff3961bb2fbc4abf15e1dea36c6f38c58b6e523dcdbb2b30acfb625af2751164e4cbc7f8663827b78a43f0adc442de331dfc852e315e2eb39bc92337b34462c67089fe7361ea52c3d71b9833a86321fec9f9f06e1ed710deee29c0ff3166f238137cfc63cda1bf4f3ca132af44710363b9c984b22180d42c8c76cf39079b4423caff4fdf4293cb464544b178c5bbaf7398f42faf6255f3efea1becbf7fbbee2cf97e34634f4c7703e7d17e737e8f06cbeab35dcbdd203466aad7ba2433749846b4fd8b8227b0c9e3272fa34e828fca91fc4130416d93cda38b95acbe7b85f7ae2e1d5a7d5a59fce6ec08065df5b304cdf87e149178cab75c86b76e8984ae69550f71d9eeb7037e4427bcff7f1f919be3f3fbaa15cf1a1599c48a2b17245b87f9c2da5a7ce5d5ab6484e4676877f13edbe0fdf6d79f6c7f0f787e8fbddf9bdaa83e576fe6f5b035ab5d789b8667f2469837fbc55a799e736af360f1b8b56088f1e74b1fcba2f0fabfaad795266c675fb0877efd05a9b166fea1cd5f84e7811646e461837f09dc92e05361e3f2834024ce73c17da9440b4f070671c20e443b076916d939c68fa612a89dccab666ef8cef23f56e8df943f56ee3c21c8c79b3c83d711f4ef4429e0ff7a12d2acba04d3777f0630fb2fa5c22791f27f1adb2e7bcafc13b3e2371a27b7ce8b466fd840827a6fdfe386f95bda34b9ece752a278f013a4bd9eac3e5cb1cc45d49748a80e7362ef23846ce19896b85c80663534b60539bd0a6d8d8d1b86330823a39977b1147b8a271742ebd99f7b93837d457a847e9e580adce28569e390b3d1144d2089f7dcd0961a619ec5c1f1af8ec0579604f771e25ec5dad3a834941e10df29799c1c916a9727a1c08068fce5752c7ec5465fb755bfacc90472aec1be25e67b8b9aea3330b5c8e49bd7d3676129268643fe128061fc33d6b38b1a6e1c4f2112e4ff0c79517e14956ce8838c7e724836262355e8aec9422c289461e5d0aec27a69afbe994752863cb8760675b5c3e31959d231a106e64a79c502a0822761b54e7056d7cc0f942e797a2932f9e6a3d897dd2b438f453638bec8a2dcfc7173300081ed3c917837aae55e08bc6d6118dad4dc7a1670a5b1b96c3e751e7f33f52736714878ec91c034b067c9813aea9326d1dc933d9b82a37e61363ebd346e9b4f54791dc393c177b74b0f54476e9d4700806e19a873c18c597fbfa14f54709a94319251fe69c2e0cd07c2de84d28f3ccb36d92401a912c1fe667656f9c935ed0483ce64d76e98b7181e8b6c24fcb2686e644e249d82ef0138170e624b1b038e09787a363c99463491b9fe6805d1ef09c5a5c19980ca86daee73437cbaf601030af98c7820265e4e9bb3253f5698ecf1683d217a7c8661188fd4bdc548e51addf14b9b3a9cdb83eb3aeda4238c3b0b2a99f72abd3f7eb35e3d0f2de86eb8032b655bf5767c20bf2a2fe8818f36613e94154368dd035a11e32cb9c046c9db37a102e859578b2b0ad65ec581ce1f283c3941f14c108ec1d8d833a66ee2542e16883681cd56395ceec9d183e7625f1a42cf16a0ff25563c8beea2427cc68b4de528f72963eadbe7ab441bc58dc263083dc836b0f8ef398dd1a4b28f36a7b1c3d24cb4d159d1df1b3fc12df103fbaaa93338b500c1da03347ed45537bfe28885f67996c53b3501e15503ee873b2e1436c6dfbc5768cc6ee9bb826134b7c6727f198e62411ea8d608be89d977e9f24c14e8a068597b084c4937bdb52338997b613044f719c9459349e6f4247ec63ddcb44ef316fb1945d60c92bb83796453c4f1313f25c15e29bc0e7419d9d67b22b979eee645e45fb226c0f6e6cb12b3c9faa3037b8d7196968d847406631dde233ed16bd022f5563c752567c887d20744291549de490bf43c41e7dd1584d6865e58a46616bfd31de672b4fdad05066063b52f54ef8a20db6130aae55169d5b43d9e399c35de53f319f114b61ae0bafc6909ccdd1d9686757d13d2c4f7816b7e16343d105d569b5fd3cd34964e7f22925734c7289cef6afcbcd66862ab5ed2ef5182c2210e611e4ffb7db6efb59d475eefa66d475b5963fc7391eeefa815cc083ebebe4cc10c0e91bc971c630cc9f4f7e150dde54c278867a43a5a7472d7ed8c67754ed2fe0dceba671aaaf0f055de331ff0f44b0752d281701f283704d260f782413977e128080bfec7fd83e33abda0880b7cadeee0bca2b9e3bf169edad3eaa31e23200d2836da9e00af706abcf0d6e3ad6ae61e4c3bca165380f380aab7d2e7e3d8f9fed08e9e6f9dbee8fb0401339c574dfcf29f06e00d5cf6612e8b7fd1d3b9f9648e03a7e8aa4e91e49743e3b7e8ae8117da247f5fe50402e42f9570281af0402ff013e969055fcca24026e9e3fba9b32f50b7713bfc3b59a6235ef22a9fefbbc8bfa8d20ab6c0234f38320bfd3bd0edbe950e49f66629d3613633e2d0ae99ad97418a24bb2f782403b14cd12a8d726bb091adfc79919c3f469baf74614126666fd1e79cdccd01c7c31b32f66f69fc1cc5acce6dfc6d61e976e1a80c57af33dcc3e109c7255ba61773df28359537a3f986bf6f6e7825218e6738352985ec37fba448722faf7b23ddde43d1fe46b04cdb29d378251dee26bf0e5df22aaf2826cef06a6b48b7c05a6fc6df8ccf5ea3f45aa2ce84d21892091440679794e80b2762d8ef369b0b54975e9539bf0d522c6ad9df6127da3d1b7311ff5779258c5a0f3830c3d0fe2c8a6d86d201a9d8097c693b21f8e6719faeba4c6d62e3961210a852f1e80210a47b75cae8251189eea304f1ec5108eb524263cb79746dc12e7668943c7924b8f96c2c53edb4dca81ec27061158f2561299dc1bc0771ccba7456fa2a1504604fb2fd8ddb690bb59ac778bf5c718eb45d99aad3244ef0db67a437d64088aa529e6b319eca7ed822f192cd5eff449b24fdf0b63bf5620ab11fe8402495164977e23eaef96924a77ba3dba61b8681abe18ee17c3fdd30cf7629d7f22bb4d5aac3604c5c214f63e3f88aa0327c34ff6b0feceb6b8bd1466b2940479202e491b0567103b9f5a2ebd445e2e3436f528f8571a43d65cb1e5393a38d29628e06c9c043b3b1198492cec7df190db1436be8f350ef8a9bcf323ae77828191bd0410aab524fc12d5cff910688e19e43ead023f168e0b935921764f063b3f29801f4358d48d63c9c7311f8ff910e44e04db965030876701d8ce7666298c9728ba6d29eb095008db920983624b9732ca312f45137430b30f83914c3a5adc9344a3f4a3c1f245e3b6aeb987a242e62d190423a3f470fb63de22c6ad77b0dcf8f584879f811bb5ef9a36124dc8195f241988ff49bb6ec99cda0437da11c9a52f14c76024e75e1204ae09c59f9cd8a6021634d9e3c35886e2538a8d8e8d529919081fd22a0b556bb9f42c6ee3188846367c085874b802e1e1872c128d3c87daf04561eb9ad30c7e1bcf32f97596ff5bc4a59fa59bc24d8b8f89c9abd22773cb5f97b491a17f9d74a47a64afcbf63e3b6923d1e9301db64ffe211bf1df26c5d6233e2cb82702d1c72fe1f7570bbfab357b127a67c26c34f8250c66712816291cdc074d1c37ca37e7513d92793b0907f91b4dcec91e6233cc77a444121d9afdec241c9f9625f6320947a7dfa5bb14d9bfa78ef71892e9d204d330927a84779370dc2f7f4f1d275892ee74bbbd1b875308ff5face78bf57c90f5dc58cbf799cfb9061def26e5e0cc58c16bfd9d34aadd3cae356b8732b613cad8064d99aa0e2f032f5553af64720fb973cc768e08969e68ac9a7679f92a0fd2c492812382e384c67fa1d66e9583e8223754ea95e4d137f78533927314e646918c4f0b9b8f6af91fdd79f0da058cb492d92613d956bc732816ffb5968463322834db2fd9d60ea5008bd12cbad428ff409b2bdb5432f8ee0e3e8eae286cd1ee84aa762994d10944638b42cbd3e9cea604383f9d806723db54d68ec9c46d1c236dfa5dbcb160315281972ab947314734ef159ddcc1a7e089c23110c16a66710576fd8b7f1a76c75296be487e162e4bc754739f7a13eeb96332849fc67fb48f23ae0f3e0be6a36309c865a6d51edc1d6e3d7a06d7e3dca5c05e350f4b9f56739b10f69ec6b1af33b4167693c4d8d89642b8e6746b537db81b2e4e74d4df3a75bd925979907e4562e7996c1c980730a184bd6729078f678b05fa5be3ccd83a3cea23e743646814dab421892a406e84e60ceec85a634161bb91a371a44f19b124ca8c845d8f42b84be41387f492e9459d3097f08eb0a24366e25820a870b56d9795469bf64eb6b02dceabcb6994c15866bd8b6fd3d866cccfe2c84f8cd8b5a6d1733408e514af0d647405f837ec674130f3e7685095555ea594d8c09da4942ac0476ea95257e29df67c6d75ca3804a6b1b5296354bb3d79b40cf1d59b836957e2e5bafcab14ed431db70dbf2397d9537fd3e855db7f8c66e178105f9619b82b965305f2e3726122837255169cc380dfe1315dd2ec2c8e300fd1217e92133f619e106fd28d4e302a9ef563164a89b2b4a925b04a999d0cf2dea22442d7b443ecfe08f151e4feb0a64985f345e2d407a4cd15ca6d97b4d6fd56b5603912c17bc2414383724be6a035026174ccca681e1bdb6008e143f38c0ce5d598631bf4a3c9a0aa3f00cf8e153c79227281eb4a22a2c5cca339946626108dd24984956315ec18bbd821d866d421476e65adf6a501281d4b25fda47372538c191010cac6452e70cb9d3f9a6dc78ddc645efc919a7b54271fcf1a78d8166c433f019463490d5cce48a62451cd6df3006c7ab6552b5938d6b82a1fa3b3b429a3f7aa718dc584d75a8704a7beebb6519ba7fee310e21a8f897b632edb969d7a3ee4654b96629ac1962bc2b694359e97563da24d3ff87d80ac4cfde8d67c234b4d9853b62585e34126e33533adf21be62d1ecf68e8376c1f96a38c03d459aa721f5ca7b38fad39a35a631acab3f87bbdfe300fa9bec17552e111ca602c13015e43b85e38aed60fa62db4cede82e1868c0570ade8286418e22781f280a99f2f70f9f1396d5b1e253ebfd473ce2c9390575ecbbdfe4e1a566bb1c94589e6a1594baac920ab29d64539c14bd57a3d86e3b4a1d7fa5ddb5a7b69717dcb62db6bb50f711bbe607d78ab5206316ef191b1d6c9c649ade73075f9fc167cf7db605eec24876b3443ed5d8fa3b20eabb9df84d83023c79a65522587a428dece29f977c75488311f6fc7f7689c8f111d625974d95623a3f249cc466e0275f1309f4421a455b42e6a5af52d0378890254f44e5e06225b42dc8eefd18b50d33ea615bd963d4f4488adbf0108f8f02493aec7d3d699b675fd769f37d6cbf9f8aa7573a3ed467f9ec4b8cc9b63b9259b7461ef19389cd1bfc42b5a5fd73885b26c02ea3af1e7acbb0b7cdaed6fd7e37e778d5ee136fd3516ef709dfb6f9ba050899f7224fc7427e833ffc14eef173a41f77b5497653edd099a627b5da2f3c76e25faf21bfcf21bfc4f3273217ef1ab5d0661279fe62d48f5bb7fdd9d389d4fbb13e7eabcaecbf66992a1a9cf67679d1ed3416cf2e77357636cff6dcce68f9578bb633bafbe7e19cfff0e5ce52d07c173fb3987c373c33cf7aa3486702f2a25caaed6fdf93093a5b4e841fdceb0e40dd4b12614d41da5f1476ca1953d7be751c1c6d198d4a30e4b2ff1b71e2da7130a851bef5cd1587a2335c3cfca6bfd6cd1f6410ab39c0f731c963a2a7ad248ddcd4596762cf9c93649e0c17d648c522d87af1639af42a5439f56cbc054b04dc3bc6e77028cad8b3d2fd0c5668ea94a8e296c027159b731e6b137ceefb5fd6d66717b4f042bdb42768e9687c920f3cbc1ef0b7a1306223bf5e800f8b1b2f44c6303f1364761c51cfb0af735a90375e97086d3186436ad7624b1c1f776d69c5dec431fa58bcdd11c59b0bc79205c81a5a61a53c3fa84ee6d2065b4dff1e9592ef1e1ef28d49864b3aacc6bb01a6eb56aecb8df42732c65ef51ccd1d1f6b513668e71051294825063101e5c5301d213c14abcbc712d07788971f44b297c1d61f8d05e2b75729bd2c3973911c2fd9e6daa795d0e79eb68c8a934a9f61ccd9e559a13a197804ddbee74ee79d394aff799db0afe6afc21c2df3def18642f9a9dec587272830ee20aaf4325734c65ad43b84576eb53464fa7d56530328ecf11f7b139a0b99d972ac02298174360e7aa219b9ace8c5eb53d1a670313b61562e7d6309311cef158eb3df4b9b7d3ecdc930aed9f2a9bee24c670d7f33b161562c1dff19a9ac527db19effcd49816a442d8108fc72cfc29fa32d8e739a9beea84a11931785669625339f7c271b11027701d5563c41e62d84e7b491fb768a3da4733185ea21a0b8f1c90f3e712e3ecb29d937d7d90fcd458ac621e88021158d32e4e95f0615a7a9dc7ac6e918aa003479ec7013be1b1971a8407d91b214d847fc9fcdca053382f7fc1ba218d670dae1d8269f0f33afbfccbcb1a39f909bede50c9fbc8a565cc8f0ef183a1bfb304c9329d4e87fe6cf5f8d3ae7861a92e4177994ecb199ba5bb7da6fbc69d657497a1ebf2cd08dfb8b3ec5ef9bbbbfe2ec3f63a3df6c65dc408ff5f6af2979afcf36af25db7ee9f5792e3c813d9142bbb0cc00e22f2d6b1663b9cdb0e3deffcb2fecb1e5d33d8da28577d1cf93438da94b0f17e42a9ae987ce19a6aec89066d5b32b8e1900215ecc231951dec23100deca462e2f79e0962473c8080124a47931a45d94f8c2ac7767ce55481fa2d496053ec7161298463123b87ea17d5fb337826b40caa3cdc6d98f77ec2168ea5668e651c7f16667f24ef3cd3205cd1202b7c5e382370a5479120109720b0a63bbb3adcb4ea8bc9caf3feabf238bf5ff8b1c381e6600c8e8722e9ab31fc9c834585d38f1e6cdd30ee6b72ea950ca80fd220dd20c7078d2d3c4a253d51474e13b7fb79778316619a64770e750093a4c623b3f313bf82bd5636c2dd0de5036d222bf7fff0ee66128e8162534fd4b7f5bd4993842d9d2a871472f6490ec0a6d1e62be251be99fe4e12f0a6aac95d451965901865ed88a1a3673d4321147c5e3b6070fe88dbb8a6822f188cce22d16a58b79ac9e4f8e07d8aea8fa1a26a2a3b2f717207e737ae14567c736c15f680726c6225b52ecb8c1c4b9de370003f932a85ca13117d6f2d0ae5bc8c500e3deccc06db5e791493b8a68f0ee4745a2d5d9349c7bc5c295507147ee00176efd1322145a8ef8d6b4d3792d0c7211789414ce2aa5c144681a8eca5685fbdcf77aec94810561df5b944704f780eb537d6607b7093f294a4d91c6c7b50b1e44abbe4aa7c649334c9e660d59924c07bdaeda4235849561275f76177f28f0593754177e20a4cbea03a1d9e3be7354fe9da4ed3b51d8f79cbd8da96cc68384462e5581ce1d1f211df83500498be1803ddb85e6fa063eccc6053c63e10d972b1ffd8babdc1dfb606bab709549b7e59c68e070af047eace8f96f53a4538f72c3c17739ac307b17568487d38aec5f9e4ea703cbec9f726008f5bafe8f57cfc1256c27fc2510a8ebdbec1181dae0de59d47ed33a9719e525e210fb028636b9bf2c6d1aa0dff0c9008b7fa394e9b835cc4279677caecc3135f619e1d2b78739ec6a2b0f7301fdf1a50c609f5e1e2b20e3b6a1ffce788062de28a5ff9b45a3aa650bc433370bd01db246fcf2d729ac2f36ab5e4d40428849f184befde18f878737f4edfae3b196478b36bb2fb6084efb8314912f33750d761f78827b5e09bc4908f3015ef6374d72481471bc4f89cbf0c9b9baba330aae0833c646bd14d5ec868acb5e0afd7957e394f157f3a5bb370932de4de05dd8e47b7d7ef24ea6c2ffaaae7e3728d55fc8ccc1d51dd3856b0f4137d3ba3964bd7bc9c4329bb37f7a7fe905101f1ad6c92262bb757f32810c9bb8a8f41786a3e268d79ab36cc21be5be19c010159bf5feefc918afb68cb00da281d5d9869dab2c9d128f14e6f517284471c76016594334a281c73dfe48f3c1929e51ee41dcfc8e8c1b1a85d0dca33193814920ba5343a9379956c032b47e3765ec4a53ae09ea4510d6307390eb6ca6ff5c4485da8a341bcd5f335c3ceb28cc3ef18fbf70cdd3178d29742a86bc239f7efe86bc8f054c1376efa86eb14b533f7531bd135d67b9966ac33538dfd55164aab8c993e0df6524a407d73573b83554e2d72554fb24d26f6443d9322d477e99aca11ca2eacffa19cd65bad86350ab1d31932a421c3179c4704b74ecb5423036361eb54fac2f8ae910c6c3ccadfaa8940d8e62119f3721d1e49bbe81e171cb27889b71abfcfd10004e0b085b0d66179383c91c89a397e3667eb7f98e3df794c6bbd5e2931b9b663b292837a0ce4894e6a6c7c5addf9cfffe84798e7ed7c11ea951d2cdb4d61ed68cb159c2f496421efc91d8a597afc3e9c22be593f33cf1eade64e1a67ad3636d248617c946f7bbfd50930d274469ff01cd4e957b679d84c8022aba46068c6ecaaac3134b439d20f40ea951cba27662c4ec3179ebc010f0ea9e4e7fbc246a19b35cd3023c4e74d87f40494c33a7b730e819205968af2b98edb06f304eed582d2a3a17c08f37bc660de3cec9c92116d4b5d7aa20de562e9276c298d82cc31e1da5a9281a8ee02aa13a25cac356dafb250b61cdf8ba40d862b1e63c32560c723083787c68cf9c219ec354fd8d63a28d4f7ee867236990f8c12ae3d24e7ceb2212087417c278f78cd7fea8382468e44fb101d708ca6e1387debe0c4383b98807385f883fd7bd89d502ce376174ca7bb3ff199a734c9661847621df25a075ae0fb4afd76786cd5f7251f84bad149864c3738ebc4e188f241de30609e78997c795093413da41a6bfeab1c80922c586083e07d1b6155e6a79c80decdb2f3e792887d5ad0d9f5e9364d901df6decdcf7f22c94e87e85134f347a35cbf9c80be9c80fe73ec9015c7f8d56e40b89b47dff5978b0fc6ec9f153d7134fa2f4c1846fdb28461144bd314d5b9e301f4a7781949f419e20f2642a4ff4e271b8d70bb73b6d17cff3addf87b70958be5fe6ed69aea2c1d999ef7b6a9acd1759422b9734463e3ef71c22fa466d13221f1e118c7e3c8ab3ab99714e2245e1f88c36cc783c23af9bf236b498d962c49b2f4a3a94bceca36a7be6f25f8fad5894bc85fe708d9a3e97e87b993dcfa4f242ea1199aa1df3ee1bdabd2d17fab2c5e5f5cf03f8e0b9e2fe1131b84eccb33d9d8310f47c636378c6d1ebaf673c6d891c8a7cd0dc14d193efcef5fe28a92b979f4361742257e6a57d9f89d747e309d1f74e73bdda3e91ed5eb743f797ff96941262cd525887eaf713be9335d92e9f4d9fe1b6e27edf2cd00df703bb957fe0f79677fed33bff699ff31bc1071905fbdcb849d7c56b049bfff56d2a65f1c6bc2fcb258139aa47b4487a53e3f74ae4b76a94ef78dd0b937b81942f6df47c7aac4dd1d0dabfafaa55ffd1d78ca4f849aa01beeaebde81810f06ce57d958d794dee2d4ab825354a8fe7d8578d6dddac78d33b6e639bf231807d952cba4d7542dbc4cf244bad6e807b0a2c85f028b2c9552d8d14c24f8d55804e68006e3b6e4e9eaa14509ded244427337f3244e5fd501394777b886ecf6b4266c6b3ea44880f7f2afce4dc1dbc0e434137fa9e87a08842699be45112d1ed85976ee433db92979e35cdd1a9153a4dab4348ea1cdf8c8ef0f244d4a75dfd577e908dd38beffcf02ac4448eecf04eb9d23185d83519e0d3d3a83e396e4ecb86f97c4e302717779d3c3a3827f946e2e5678f9e856ac2925e328b263c87680f27a255499f6076c1d0d9052613e384aad91bed32bb0a57db80afda17f1c974ed023f2736e844aaf646d380f232072797f779c2627a331902876e709774b6914465e7a0db0d19e222596fe3a20fe76f129fc3e3512a18cf5aa786f7f152dd947c96a7bdf0291fd1a86b32e984e7507b288d0c7fe62d774a83316ad216c136b768ac118603b6675b9c57b76799e7e968ceda69ff4b208c5217fd16e1effdf866b9fa943e9d46cf11770c463269d36aefd52ad8c9a00d23f1cb4e08113ffc8490824eaff3565edc5b5b3b86a4a9fe278614747a24d3edb39f764347b5f96a324a76288661189aba7343c7adcd1a1ee2c737774df97bead0ad0d61b74b5264a316e189a8f522b2fba5187d29461f568c3e1a5c70472d3a0f2ec0ec563e62b5c629aa00838d47f938f82031880985440f7ea6a7f5df9d4f81d44baacc9269e3c883be07222890e3ff0772cf43f5a3ce0426f1b7d534e42c5b97b919398c2e53c5971d87597529322882eb8c90b1631a7bcf14f613eaf2e26476ef52feee2a78c16a32c44135e63a70813288da717f42d70ebb64ea556ae32d95d11385c8a7d5a54d6d765844b294c2df083aa00e4b3b5508db54d6130af5bf0b56b36b18116c2c9cb72bf8cefaa2d8c233d9ed843aec9c4f0f72f8986afca12c8f1827cd45d81f6bfb220b27ddceecc7ae2a35fcd513d9aacd5f100801e146db840b67edcba082cae9491ab27bc764387d18578e8d27e7d1f3bb16c2f754f90d1f82bd9f1838db17ae5b39d00ddfc8f8b5470e968e819d0fcfebe9e138c1aabb8ae6631fdadafdfe25b17200d785d95ce3228f6237139e035e22449e680c3d6a1ada09bbf390a333283c4a593be6ac7ab71f9faba0288a198dc535858d6be5401aa999831df750262749948193b0a5a3711b8f426a7b5a95a9021c02d2af2e82aedacbbd44d904288adc0f7d8a2d6e95856dd85675d12fcacc0bba8e350de19a90c45676cc3093fd910c7030077636fd78300782a79eebd0467422ef024bc50eb21aaa57a9ec0c08ca30ff580008e27b3f1500a241f59f9711efbc15fcf113d9f5eab9e6f4218123ca7f3270a039ad1d29e05e9b4e226c7c4abf74dec7dbe6062f78fb25890570ac41619b873c48f4316f394b3fe2d65006ccf0bb10cb2ae6e4e41ee2a007a9994346f6e2fa7718219381c1a2362c4a405b4ba97270e42d95f0f8fd299a7ca4e69ea96f7551287dca3873a49f1eb9a3849d3e9b28fccb88f24be74d1ce10fcee07fd1382210e13a86fce670746a8767ca00feb082efe9722ed486de4f730f71c5bd56b88aea6daaa3d5ced6d25633d58d63344effb0ef362c355d0fbd923b567204ad353b31128f9641e3e48c4d02a7a02accbbe6812894f55a72b43dce4870e221900636d2482d0353cff9f0e30ee7c8b9db50ca003bd0d65b736cb6480bce11d5f239e212cf9a6d759a2bd1bd2a51183d47462c45fb0bdcc1ad6a4307481ede583739c21d555f6516d64131b8effa4e172d8c2610e6919cdbf4349af0d831f9dc59dd28c77c9ca3396d672a18358ef1db79b5de672613d74ec17fd861995697505f3c0f886c785557e20dca123b105648b3799569417ed5c2eb39c1668bf63c1f0391dddb5076e3b9286cb300e3ca01dda3a75bbdd22b27fce080f856fd1c33bb4004c9425bb6dad887a7205766aee9813c07d78ee5ba61c8067f5556570d59c1fcfa1430609783fec4bc86a73607bdf0e40a059f341923f4ad7e72f2ce5ad7d3f128a3382d63b3cfe51cfe7247f2fbf4f25178ef65ba803ad424769681286ce03aa9cd3601b5045ec41ddd914138224b7b110775ec0f0531b48208101d611e4cee6cc8972a1c9d67ead09139cc4f67999390c404287b3f01db00e22e328ef5da7545f6884d8e50fedd1fd34d5efbf6fd4f2dddc150da01ca7eb904129f57f21bf1bac6115de2f3370379da0eec57bcafd1b1d49d5d621d0bd325403cbc1d44d304b1a48a05f5ed59221c1dc3d0e6fc124c780eee0b377eb90fa588c57baec6944c444d40c948a16d3348ab6026da295bb2ad32dde220d260e950010a9869dfcf758e63b0b74d26b7cb65c53750b0032adbd611911c45a6deba6f79ac13ec73c56f900ee0d3413a89c9a59f427a39a0ef6f04de5cf41bcbaf8d8e51d133cd113ed6d53ccb6ac6bc9dc3f774c1e2f5a96ff5910cc6279c6c9b71c7703ec26842e37d69631ad7a4cd8d719c82beb453d047cd3b6706a748228b82b470f0a0540780b4f5ebdecf0471e20cc3a8de7960ffb3d64fb62697445ce998ca0ed25caf574e9909253279b4632691c264c99cb1a97dd7d19ceef81fbb3adb108213d577c751c4117e226c7d4a5861fde62901931e48c7374cc0a0c92a958db5d3fb311f875d7b3145bc55630c9f42c1d5cd9e6a129f05f29d7f03686f58077866a73be1b8c218c94b9b32cab1165f79035eea4c75bf17018a5a8d1b145c3aac75c1e5795202d0047b3e557c01f37503efdf750a749d79164eb541ffa5ba8b0ee271aa71935988e874ea51c1d4b11ce03d6521d691581c3466c8da9c94369876e3bc4d2ff5bc30ae3664185e64d626c774a349af574a5d870a196f9130ccef4326df128cab998c27f2bd5e293fb7e65ce2974b5f2472e989f807942b55b66ef625e2aa7d33d198e6cf02f92a7c35890bf05c3f3b967ff9aecae2ce1c17a40caafbe5ba589733d85350386742fdd31105c2a321ddeb5bc352c058aced39cc0be4737a8232921f03c181fbb3d8b6d49d8f69a0e907ae53c8afe4e454b7818126f2138f6be83184720dee3926c0383adac5fcc6871d5aebb89f6a8efd4b5a8173985ed2809ab0a5670ac473c485f2a8281d93dc05a2f1ea5a1c2bf14438d1384f1e15846b92c0a2e5c43e4e8fd32378f51261ebd16420f152bcc0baedce1911b00dd24f002bf16a0fea6981c8ae3ceac04ad1205ed0d591138dca450bb8a72459b8b764513b28e181513a960ccb47a82dca282d0a657c275e35394401c588ced01c4b86a0ce546c2b6c7452b4a7a00da88b159aa0eafa10eb2b8ddc094f99e8ebacd5f3daf6d2aa6b51155e2314e8dddcc0806c0135ce915c6982d448a82b78fa523704ae2ad7048ca13b0beb63c309cfadae8f1f4fc168ae196cf1bec438a27d38256c6dcad878a211d76d209d9502844d85a89e4d09905fd4c7425bcdc2f724e2a3acfa660565e9250a80ed8ce7280bd92b1a73c93c7b04b6c17ae92c9bf2f1983755e02402e98d665d482b97b89a00bc1f19433ad555108842eef1cbd558db87d77a8fbe0e4e7a60c327ec9211a02e32e6e3ad2a1a896d199b60781f7f702c67477c177ddfc2697d047771d3c8d59eca1299996da919d405fdf2e07b001fc52299694de1fee238110e68cf6c9b24e407e4d97bb8565350db3cd0fb6a3fb29a006e198861067163970c3e46d65130e271ccc71b499c5eb65b975503d328178642405c3a65989f8d5fe04a47075bd752456c1b7bd31657653caceff744f584c5483de27a715b879c5def7b07bf573c13cdd984e7deccdaff133a62a5f3413d67907cf8583f2d60ff5d94b461445c04a9b765b2711c8b7aa3e7be0d57fc4b32b0f949f08edf7d12fc9dc228e94f7308bb74dca2d83e457719f28e9beb9f083d226982667b6fdcd9f766e8d1977beb977beb7fc6892b6415bfd2b315be73fd22cad277a2bfeb42cdc5a3a7cc903fe5a8df212882a5fffc6d10bdb3fc909f16087ecb519fa508eadeeda3d77e16d5003feccb712afff39c8c6aa787fce2645f9cecefcdc91a4ef36fe2688feea64cfdc2ddc41f7359bb2ede38ec13efdcb27ce5b4d6a3bb44f73a1ee9cf3aad757f95d31a45d37d92edd0c4c79dd6f0103feeb4d694ff09a7b56e9761a8dec9979ff8dbf8f2b7e9f9feadcb4d812fc7b5bf09f3b9c114de0d1ebfba78b8ede656e5ad3b5ebbb955ee4d1fbf48e2dcb588e692e97cbab32936f293e1db97555c4716c0edf2ae7da96375d944654a9049af7dc12319ecfca4007eb45c79e62c1c27cace4ee525f2d436c9a597cc369228940e3d0d4d923c56668fcac4606c83a87d24a3140ecf889e28a47e19e6e8b85a684c1a91633214723938e5933a3f2ab93a820c4000b7f1a91d3a89907ba211df3ac2c3deecec2ee03f0e0b2e2fbd755c74992f2d75ac5988eb71896d828d63ce6e1c475726af04ce4f1b9eda7ca2948e29108e0ebf2f4b8f6289091ee3a6be18a2ed46868f6d3f04e3c68bc208b9355617f7d638bb860fc48ee9003fe2226c2252361ead00e41a749eebab7551dda6c9f785c776e7b80bcfe10c1d7951769513b6ce23a73730ddc45b2ba200b93ff195db8209e7129b8daa35b19d21574261eb603c183ea577255e6ec38b2f903564d25b65a19e1a6797ec19145bba9451e7aec4f4334434c339a2fae45164619b4c3c4674148f5faf2e3bfcfca8e936af0ad7b9bfd9f91f535f2e0bd7ca4b8f26febabc369d5f96d786ea77885e8fec7c768e2e8a655992a6883f665c42c8fed250be34943fa1a15c2ee4dbeef5f7f58e0fb93f37eef597ba892fda8769c9ae3c4a2eed2a2af19edb3cfc8bf3cdde76ad6f72ac43795fe72c4f8dad8a72202a945d7ee91bffe1fa0684111d0d6159fd1e8cd7973dd5f993b18bf912e922f7dc06af5cbedab98eb577fafef37a05ca41ead3ce4a8af6f0bd16989d2ed6a5514ee979904c9b0b86208e6714194f622176860d5d673645c6f858efd7ea0ecb850b8ae53ffde5c2ffa0fde3668dc6e04bf4deba20f3960d84253b3daaf7e93690cfcecad20adc237add2ed17beb32a00bfb041ee2c76d204df99fb081f469b24fd227a32f9a882f15e34bc5f8132ac6cda5feb37ac6c5459a4913ce757e37d047748c77ee47f9848b36dfad6fd3327044638bc26d46d39d6b3247c754483fd1cff417bbba644e1f2acd458daddcf517ba8ab2f392cea6b597aef2efabb96dee3369352c95b2b39fac06dbe93ca350ee78a8636099885c668d91bcf45205b9f4cfce61cc5a306ee789415f7e1f6bf1a6ba3b06df4931847a9fb2fe49f91ebbe60c87a789f55d0d77e57b6d53c0e15b757e74a47be94d388e17314f9ec81e1d0d5f6478c70d7dd6b81425ced2350fc0e139c22bb915ae8b5c9db68ea9201794331dade5d28aed19f83e8a5bb9b721de4e991f70a821be7b02bb80fb89716cbbfdda6575216319c23e5aee56f2d2a68ad4c7d9173214d6368b3f7809a3403a96422cc8e6c2d16ee55a9fdb94c18eab3b50eeea44805b7a90fea04e44198424d6974572e80243e43a557e48372b1d6bda6478c07dfddc6590cd6598e56df85ab0ad301e619ff585addcb1ba84b276f56cc37a061bd2c1cf5ca831ddea70fda52ab283d5304ba272c243959d421a29a44d2938cb06d2e7cf72d0a3f23383939bcb4747a7cb606b37b3caa5a99e5b14420969444a50c8497752f60fd395bf9df2708d0fbbca7cb09ff2ace3d146895de191ab58c3bb5e6895f1459d95a2c1fa34ff3a7265f3b57d6895add0217487c7c98d598a03c1e0a54d83dfa130d3aa4b2f919b290e3b43b896f8bc0a3f3ae10367dc50f66d5aaf4280868ee92c03f340d4eb6312ab3be40aa8bdbbaf6ad900d5dce1b19b38e20189b10a9e6e848d5844e58e88ef08f1e9c17696b0f1423bf18c13ad2aadec26389308e465d3d5607f4613c8d55781bcacbecbabe2e39bd06f6817cd2ba6fbcaedb2cd5350399ea92f49456b46128d0dacd71e2fe65987edfb7d192b9b42d960aef62757170153642cf179252f99c62e89dcf292e0280945704193c7fa82e333de85f623cec636832372c33cbf7baba9f307f6cba7ba62c5abefcdada1503689e8bcb9cbeb3db8824479c5998c10ae1a57589d765606ce7004c7bcb22d0548bc8c681bedd356c8ed15f3194298697a73896e032f5cfbd89d7680f793b442d9a584dc60df96f5d76eb3edfda927763209185b976250b8544b47c0d994b44fdb97e2f1d517d1f2d8455f4f8ded73c45d5c1ebcafd6d7859d02b46c140d9cd2e6321bd1841fdce0a9682e238cc7f379bc33879b8bbd34e4979732e11824d310959f6f301f1ac179e1767e75f12eae0b694ade79b4fae499b3edcc54e306473cb22dfd928c386d553a73f3e8c336facbc2270783b772cfdff4a3ea1304d3f9f4cdf5a7f982de7030e83264b7732f39e02dcf2834c49ff0a4aacb7f7c73dd2148a683bcafb4d33c7cedadbff6d67f626f7db9c6ff6af3fdfbd96fe05f9c84f09e191f45595e99f1e728fafccb8cfff730e3d7911eadec20293201fc04ce60bb5cee258078578dbb7411a8b23dd5190d202df3263a1a40eac889c6a08adcce10834ce9adfa979960d0f7c4461192fb31afbde972d01a0bca6ad38afcd6dfa183f6f60fb94e54d7f60144db504d95afe85b455b5cc352c07334c0d9182ca43eb655302d30ab8841da597ddcd5e09744a6345c6a9d65c5c77496b392b5c2c2907fa553c12fbb25a2dba17a3449337772f7fd19a7028621a8fe1ba6ff379c0a10b2bfb4922fade44f682567abf867559226291fbee53f8c23b8d3fd90d722141729b63cd527a2d2d33d56daf2e01a0da249fb56c8a1d1714c850c44bd66cb6727c92d762a2c44631558eafd5b504d389e96a5e694b064e797b03e20c64fc3f776e7a49fa8b97fa6c2b047a42a8c82f8c28bee23aa0aedd1f25a12619bef5a6a4fa7cb00be5770e29f54bdb9a3c7aad31e259251298380cf635e1ecd0999330d5698033b1a6b70176e6c8304b6cd14c8ca1771c03966a1231a94631e76be48022f9d6d70121795ac6fab1f9f8bb39963c947749ba5c9106a72583a345443985382b014eed8517ee25560c9b18b6e1696674d52b511fa1efaa240b850cdfa453bf8c48d3e7853d259c966cffe175e1747b3bf2c897f9fea500cd9ffecebe228b6df63291ac582febc04ecff9db6e5f7051ffef825f4fe6aa177b65e6f0abbc236c1b62de8ae5deb2133631ba128854db43caa5b1f6ddad5f123161c8de0f935fa7b96be46e1f7d24dc087ae77bb28ddc49cb36fbaf2fce2fb47e84ff3dfb9625d44bfcbf468b6f7c9f78f506c1f56edffb1fb4730b6ff16cceb3ee36a17f9625f7f29fbba5cb527f6e5d4495346d3aec43b717d2aebefb35012d5c8a2d4d2b570323ecf0247dfdc8f259e8b6dc0927eb2ef4a7c80f4c6e7448d7c5138fa74b0f31375301649c6a785cdf36a7a9c3ed9f95502b139bb7271f250e2c5320867b52cecd52cf1f13bdd3549f062a184988927b2ab17c3d067a5937ba90afc94db79a240384675d7c59124fcd400f23e83b0958ea9e63ec92e71f2cb41ea2746ec5ad3aeb29ad2cf9adf2eb3b72de518506c894c52e5d9b7d85e65e17490dd4b14dbbafe13e24a59dad4125814f2d8005e3243b8416de176f1159e28b1a691b85608bf5f26a84bf96810da94b07245e31525f88370210f1c707c5ed947e5189f9539e11cf6e79f7d7344a92b3d0dae12ca4ae8641525457af52994706a6b53c6ab9d084794c86b9085c853c00c8007d823f22431999563b06bc78a6fc1dd75a8c3ce4e848d458118cec31d9c5549906214f186e0c049915e9d91b27d8e06113e959b7627258b92373da7dcd2a7954193883c1122bbc4fbc2e7f92082e3adbf59d4f95e03cefdc53e23bd4a94f6f16473a1245e24f8a51b6f9a570fd2f51cced565a23cb2f4c569e1507d34374edd2ec92e7d917c6d4e460d9460ed58791da5534ca74d626e8b12f69ec156c94ae5d7c09237b09cbcbf05975ada2673acd5088b56969ec56d1c832d6d4b05cfd1603f590d0eb7e0714c66692707f08cf68b87a373159d04fbbb998cb92bf112f63ae33b7be956391aa9455de949df4f9f06707c38412f4eb67ea35c8cca2138b1c7d3a6da03366b037bc10cb688662baf22e40902589474cca30d480707ff3e4d5e45624a7c3b3aad78b55379e75228c96754f39249c9e2b57dcca8e9d16726c710d1623b3ad2a2ea937cf5d5b70c1098d8e31b1fb9307504652889386a324051936ce9580aaa632750b534b690374969c503a2feee9c9ff53b6fb471b428a3e38ba0740c36f75659a8ac06fb2b386bfe1271bdeb68b43c4689fb4476ef99c6367882fc63d07f79cac271d28cefc843be4ca304e45dd8fecb13940d794b9edc4b4adf8a182deb75763696c84f20df91bad87300e17c3739e789bb292f5dcd9b9f1847d8f7394f7ebb8d735e992379e3d05572eb5516cac8be63a44d02f69f1aeb7502fe1b6bbc4a2ea5574904af1282a37b8320dc1625c4ce08e245ede9b131d40ce575a6ab433d25eecbb851a1a9ba2aeb243b9b19cadc20584d1d117f945fc70b5211e640d54c831dea04c3bec38fdbe5853970643d669fe7baf16c343034b8bf83432c67f1e5033764e5a8789ae98aa41aac342715fd4c36d34896d01e2dc7081642e166c452b0488ed349c59a93f2f08afe21ef111b7e1b2f0895b748439b8f88b14fab4b87025be7988532bacc2280f4df5a1f17b4f267644d9d4c7e9edd49149feffd848d83da7315f284a77dff391afcdec0f96be9f2d3b7a641e6c78bf52bd6dfdfda95b60b361bd22ef17e32a177b7a53f9b428869ef4a7b9f16a77a95ac8c246986b87bf1f81fbf1593a47a7487ec106f19d4ba0cdbebf4d81bbbd22ef1953ae82b75d07fc496b8cd327e65eaa0563f8f4fcdefdf100b788ba55d946db81a49bd75464eff4690bfd124e46724fd83a2bf77bb4487ed139f19318759dba7dd74d7eff64896a01b76d2ef517da2d7e9dccb8ed66348a6db21e8e674bd1ee11d16f756f93f607843f8ff5b18de1ecfa5de1d23dc79a12f33dcdf84e75cf28293414e05f2cb5ce3aa70b260e5221fbdfa37b9714d869086c25c120fb997cec63a309ea55190b9a6924962759b19a5e413137fe763479b13445887c03daf86dbe97c56d8a9816e53e0c30c85a620776c4a0f3d13e0db394467e7471cec8f5c0cf2a739c939d248d90596bc72ace9166dd6f9c1ceb1d4d58412f601cf1e3dda286dca384e9243eed3156c22fc46225fb5c0e262db52011fe6c21cd8e18c085e2cc2789ee9f254d567ec74908da512f549f0b1f2a20b7138d1c8c44fd8e2058f279cd0c6d18fd8c8353bbb7a4c3615171eba6875b073a8fece4ec07642ddc0458442ed722f318e8ec6156e95f9dd41e30d76d288831bc5a54319471ec8c63ce252afc4fe7e13cb0f55829de9316b9b737c331cda4452c1ce1fc9bbc528deda5467e3c0cdb6a91018af2ab0530367061fa93b8fda57380976921880801f1481c65ddc8c17ec26a60abc91032654fd179d1bc5dec81ef3b1f16c44dc687e1c1ea63af9a20ac6549d933b2f44b8c3c68559ae197a2744b7f4a0d0ad776f985b79e6acbea50ee1096ed824912dbd565baec91c511802f20f5453af6472af648197ce76c84d1f6ef886dc06ce319c7fd40e2defed3d6ec3b638626222c784dc431ba6033ef8a7d59d9d1ac9c454769ec5e5280b3b4f32ad3940f433b17448c73b873a804952dfdcc7ecfcc4dfb5fb69c6cd93e4e5d81d8a4d3d7cdb1fe15132e944f5ef69e150c67662063b27118ec1e0436de1b0528adcd9d466e799eccaa50250cd05e1580ae17f00269b0e806b1e3670ee03d13806a3e90ed1cf08e131ac6e5f583a947e413f17b443074b4734700a308aa53cd328dfad43099da08474a0ec82a8bf9d449db7cb13c2c6318b39e64bb00ed9f1cc3d9a6bec8b49a20b8c212eabf9a3abf041221871c880753f75d9d5cd95a5631e806d29c02fd9d8a383ad27b24b8767a9a9d62127abe1eeb24feb38dc2a5ae7605170cd1b1d8b120ae7c986787f917896f46969e789ecca36f7159fb8ec7390c811b7724572e7f083f5821fec024b2927d4616753c26642c9797d2327a457473452c7ecfc7fecfd5b93a2cab63f0c7f951df3f65d6b96a2569533e2bd50ba44d0b25b2d39adf8c70a0e1650244817a262c4f3dd9fc89199901cd4eaeaeebdd77efef362ce2e2149923c8cf3f88d9988dc6f9b70ffb456650fce706f911b3940a6cd7e072c03cf4cdeb3f8cfd4bf117149da9418e1fdeb91268fffec0c58a4c9f0574b8ffd5febae2db32ffa5d41e83cdcf72fc02db42aaf1f548c8587fb6eef8abbf69a62cc63eafe2d34fe2d347e5268a467b81416e5fc891231c577046f26462b282be604e3c0d5506a620143a442c1ba1b9aba710f9e537d810962243fd1f69199d8d2666f4aaa208b7dbe9fdcd5fade5c43b8bd208bcb9d3375c3d9fa319bbfed40d8727aca1bc18c6c7fcfef267ee92e7b77b6e99f2848f7d74960a56541083b0fff8384b0f74bd5e86eb70873eb0f3bbdceb0f778017bf72708617728dc0bc3c1e708219eecbf09e1df84f0270961e524578259025a99f37e9e0fa97ea79ce7d124b574d4b599dc2ea981a54d325952ba4e6f85c91c89dd8b56c8080814029653992eece403df96d0c0d5fa54665e212342be8dc9acbee898babc67baad93b338c05fef1021b3fc11d84eae6101b6753ffcc174e07eafff30f8e5d9c0bf2ccab809b5d5bf7fecf5861f87da225f7889f45d6bff03d9c0bd7b61f0785f426de165f88f20817f47eefde7133dfe209774ced686a1ab9d903cadc6178b5e12dbf9e8347f7bea8b5e929bfaa46be90a92458fe4c64aee611ea103d869c4b1f02cf64fcfeb7e4ff450cd26b042a63ede1bfaa8a15fcb5303f79fcfdf96b3d972377322f5684b9337733dda4155f3aa5dc8fbf6d2f72eebfbc538baf3b7d14cd4aa76914d842048d07cda67766f74b0b5c99b25a1ccd495015474bc6cd7da1b1ab569c6a1e782cd73942df2ce71fe261f9fdf9e8e5fbf8c84e72fa3eee2cbe8387f59760dcd0ccc3705992fc66031da25bf837e3362714544c52d7ea88ed72f776157ea78ddff3e1776e761d0ed0b0f97b01b3eedc2ee0c1f8561ffe1aaa62ef4ee7b83cee3df75bcfe7661ffefe50db0a97fa7ef1abfe06e1bef6fcb9bacd10f05dff4fed911fed9797ce9deff253cfc3578f87378df7d10fadddee3af0dc3b9ff654af6c3e0b1df7f7c78e4a4bbfb87fee3c3a50237b47d914c527ce0455ff5e5f69fa0657f87e3fc4dcbfe17d1b282d4fc77d1b43b58cdedfbbfbf67dbf7fc036a754bfb12ca7ad8bf51cf4bf867a7fbd27dfcab33fcabfff8e7b07fdf1f7404e1172ad884dafd62dfca7db7a476c3876177d8b94ced84fe3d1917a15eec0b2f52bb7afb8761af37b8ee63190e06f78fddfb96ba85640118bdebffcf5a172bfbf9a29e5d36f85bcbfe4fa13e6d44a152cceb30f3922f766f3c7022145bd3a5f7fc363a7d5d778f4ea44278bcfc64fa86a006b6307c979f5066e47d6f09910e4a620a7ec721e0b48068648afd992af989938f69f4ccc6034ff47a8cafa596b64076bc40a638eeda11582a3b96b4f196fa9884c17f91b367b16c274be6c1d506a118a14c9eae76e67afcbcde2c262f6831912570f27896d6f75cc9470c128145acd83d65204bc3083f67e9cf9e230cbb4eb440f274959bda261383dda14899ca779e290c0174f26bd08c06c1f360e4c3d8d4fa1901d31c7d9ff7c042f1b0cdc7a1a999891d6d3c399e74419b0f58c8ff2874a47d4ca02f14df11369e220ebed8c220b234005d65d9d690ca466a7dab7b473af9aeb4a1a08f789e644f112fb5417b471ae6ae58b6b1b42e806183a5448730fddcd006b1b91e85c53d3c26089947812da1ccecd07ea6cf24956c8960fcacfd3c9ca4963639cbd3ee501695ea3d52cf1d3f17881eaaa51c8430c7b2b8224e3c49cd8b77931af15f0dad4b5220483bbc7e08d234097216ff3b346235b125d83bf0adf3fcd103443649e95ada293475198fc3c36b0efb82bb0e915153b246a406fefe419e8e7d4758f8b65849018488214b5f25a6fe9c89310078b6bec7e9ad72172039d2b2e6fa71a718bd555f5c86ca0c91f71535f8473bc5ee29f8794554f7ee0bfcad40bd7aa8fd0e29a1cf706fad0d3a2644a88d3b764f9e893a4983318449c7d49f3ddcc621e9336f86be42f3b896aeb7dc2990d6203e7e979f541f22b7a4d3c1d020b5c93323846c69759e076c3f93b573a24966e2bd4a01c91571303125173924a549c17b4a1657681e923e5fa4a16f3e9989a99d42b23fc8de37d7830d3e53733a078d7195e916dc3827a1292148fb30b463314679ba3ac8d224a400e74037cabdd0f766c190eb67d5b1f5710afb6c3ace2d3c8fc1283025e4db92fae68a8fc8c987275753f3ed7a18599a9a9ad3e700f6646f7570a2e24c2a652a91ef3bdd15c2fde2b92ede0196ca90b49bfa1d139f0969d2c167cd95ba475b1c65a44ded5c78e1619e8fc97a475dbc86709e01ac3a0ebd991466e6baefb953c57723357784498af7aa2b79c9dc837141ca8d2b0d734373e1fc300b6af90d03b13873640c47435fed6409e07a7606db9384260148fb6c3ddad5fb9e87953d9f32f06752a46587bf43b7243537d763dfc46b253d7976a4f6f0dc17fb753d8eec9e5c3f9319830e9a4deb677f3c7c5d8fbeb79cb9449eb83b5357d06c3a3ecb623f9bc7fc7b06f574de84edc70aed201049844647ea9ba921c1d495e1eb7a708494446de1bb927a96bfd0223cda206c1f63b5fd5c1c1f9dbc9fcd09f02e72a76a6e07e3da7be03b668c7ebd48686fe94b3a3783aead29b8ef0300786b8b8313b1fdaf603e47fa8cd450969441c1dfc8bef9dc7c3e9d125b439d9944fe9521fa34f46675ba0d7b2c9dd1f35f9f873777ea65ec5bbead47914dfefef83c0866e4086a07be754a100e65c9cc6da1f3c9ef1a4666bc40aee81fccc83c9bfad2c3b460d6de1ed04ae0fa544186a0266e44be791e2d3a5b71f08df501df46c74a40e1575d27ea7b6dcf023c56f07890276a66e9932e2dca10599adbc53216956b282d1a73738dd7db4cec29ecd7d0d5412e22342252df300f71f2f1c10ed8b37d98c3651c7a050c8278f45c6d90e0bd214be82c4ff15c10fae9c42a9a8b63df8e969e2180f71801b4963009a0f0e4543d131e49f697ada91d4b1a8673063726fa6d6bd9ba6766ebb16a47e8e46a9b5d391ee5600bc71f389bd07f86f9ea1cb1b91c0d6511ef0be8fbe3fb8cf05fcfe98d9121204cffcfb2e41f5cca57b8f93d5bd2b06b4b4bcf88361ee1db0ac8b904e986d2cada9ab77f53c8debbb3f351360f06df1c2c0387b0af7f781ea80ce15164516447cbd6b140911849cde7e2f8300ffa198122533baeae6472db5922289f0fdbfcf13b5b7b2ac3ef0c90a3d1ded24ebe13a1ce763dee59c04fd410cb0b40832284e584c40ec66fb684b0fc9c513e48640cbc3fa68a6f472ee6a188c9822b6d15639906644f2a5f60994317ca330a307650bc628965132a8b0f56b0ff3705bd9f896b7cde88cc81e7c491266703f34f90594a19cf107cdfd206ec0cbeb9ba9283ce436544591a52bd83a2fe4a1b0e0a41f64c3ce6757f266a9fe16137c7b72be9149e5ff5b5b9374285c90b786e66a25995bbc2456a696ae63eb13ebb0f98e79b118abfb69f918cdd7f5d87543e0f773390f9c878e73191f5bfad69011b09d3c63157e4a5287a94c8a20fd90eea7a4c8b2f835ee69bc2265b56be85420432b962cd8adc5410745bc78be55f479ae4a64ac647c67301d67019967b1f0ac490f7c2bb40e761729c9be06fc4df03f29ab8f464c49daba03a0e3984fd1fc07e1615050a0c89b22747987775553918ed5f69ff746fff887c4a64b6a0327f1edfdf7c3deef33aa1325ded1de9e8cd032aaf2f39d953ecb26b05df3263e560ab9b14e664b4a37351dea77b0c508df17ee4e033b0ec2759ba7276a1f80c6e5f9ca7885eafcb634cb71a50de4ef577babf8211d0e80d5d27271ffbb2987cb57b2bc9eea999f944f6883c7577a6d6f7ec88ea7fe2a0d023e5a9eb3b399637b17ef1395abd8a50eef4963bcc6fa0a813d9d359a1c761f9883ecb7d5b5addc328b3851572720e02b35d867cb6b46ee28ade69b61e8c6de9b8c3b245f1aea7e191eceb63ab8c41e493a3278766e2c48b8e1c5c2d5e55ac2bbe46613339c4ea13b2039f8e3bf4bebd74bc675a48a97e76947070709f888d6645a2d31e0a1d84b76dac07cf86a6207bda21ef29f934794f373deab4104f212f3d916fd144663bda23766d2e8e035707f8cbccd21667a29f611986ee0d69f866f4d4dcc97f66ed57c675dad6587ba031768479e5cdf5a667c6c73273fb5a8946248b13c5e994734c6158092dd349c1a8198f385e9b57984bcc6ff1fc88cb6c2d4dce2fc200ad884eadaca8ee8dcf1bfd667cedc3e778bd5912dab1a67213d341886ebb2efa919ebc5963afc89e2bf808c69ab7f7ed0a9333b301629df727d6525991b9f62ee9bc96b66474445989847fc2d9a2c58168b1ad849e21c1d065cfd2565058a8ed397c5e2ccd8036ac8f4b3c8cea4cbbe77598ad37abf30ccbe94f6cafadbe3964ece985bd44ee890ad9534fe8099fffaf79dbd92fcf301e1ff081f2cc27e6f43991bf7486f29711e597888e7b14bd44c3ce0a0ab7ad264eac1c9c3865f3b534b4c53bb341ccc3936f446a5ae331b4d81c4f43505839f714d9bfb217271ed8f16c09bd9beb71f17d3f72fe3f259b513ab3dedca00194d6ad374b2a8b967299a52dd35bb24b6d6f266ccf548bd88d222518f96b7d51f240d107a8e3624d611e9e770ca9bfcebf2d6d7989777fb3a3d3a0b029c4cf9eb11e83de67337b27cf5749e1442cf753de3a0eb6d5b38fbf39737a2a14359225d4c132b43d0d3da7e4e7073bda644427e6df3bfa8ef7d8464099d953534357c83e0bc63d4b523359ea1e9c68836505df0ea08f6be34b78feffd3f204ed97e88993dc5cff9c6cf1220cd2edfa3a3d9ad56db994a71434481b1c4ca95a78b1553608a96de78b9cccc3496a4361af55f1ae97de08649cb978411f807ba3808c594eae155a6c97ad018ebb2848664b6a3693c8d8677c51b22f4ff7b53ea08d761ebb731164f899a82dc087f2fc65d722c77c8e4615b6922b7d36f6e407fa7d2d6da370c6aa720c9c89549694ae2b8e8f5000b0f70c504686d667ebecd9c202eba4e74fda5ce87bafeeb3ef4dd999edadcd4dfac5ede36bf226f9bedcafc0b397f33c0ccdc2e6304e4cace784beef748699b9213e052c0b147a3dcc1dc272caa5f9accb2f0742e3eae7690c7dd8c1076597c922b123b76b4b93bc26bfeced9e82f0bbf0dad9c2005d7a174f93888da249ff2afbb7a07d83c3351af4391ec7e6f17f815c14fc945c04e760bd5906b34b9571dae4949e9bb8d26257918dc83756e895a82128d780bf07d32a5214118a251ebf7abf4086aa1550143518ff50164dccc35e309f75b593ef84848f6d992c8d18fac386cee52430b5d3b090fb98aee041b59dead9aaca5d6cfe7e805e7d4e56077ab2414fd76916d315d0d31c11bf2cd3bdf05adce687957d7f41e6a2f6a7ba8ed522c392121858c72a2169c10eaf9d30fda425391a767eb0bb3bbc4c2201ca88670b86476c82a7ae134dd22a1df99cbc81c772c34e67ebeb9bb60c58936febf1a7e407988f6a75b25f63d3e3745e4b3b92317bb48214b31933bb34967f26fbf66f6d9b3bb5b3abdacb214343213edb0572a6ab83132f6caddb69f0517d7d8d361d3d47972fd12d16039094b6103ceeca991cdbd2f177cb0f635b3a5dd77f267bb729a7fe90dd0b6c5d788f5c952b6bfacd2bbf26544efc115b07e6253f73362bf2d2137afabc9e899fbdce7fb56eb78ddea5755bb91b4d72d0a9f3b1adeb2d7a29f012bc96a3a1fc393eaaaca6a410f847cef0465053535b7466edf64009622e9ed0d36c3d78318449c6ec9b4a84bfe1269faeda2edb6c0b79d3ae80d7bd2a87e3f93f7a46ac76e429a1f50ec8a063ac63222a831d0c61d175e2e74cf4d067ce91b29a2e6e9ea3365989ca1b58f6b8298b837c44e82479a65516ff88ad6075bec8d38461d78e2b6783c4e1d0ebf87c90180617f3a4acb0e97f8efe7cc1f2ad7b533e1d74c83ec06b452b8ef4567d2c937dce86be38b8daa0f37b79d5e858b1bb973c0cfca9d5b82fe5ac092873279d6b7c36a5739d98ba42e66d92b6d09cae3b13c31fe03b642eaa67865cfbdd3678b2d76ed8df204e8556a084bd7293dff07bead33a2bddef453cc14af7813ff0e7c28921e622aaf116f0a19bda0901fcf8f4b98c5f95dcdcd0c7bb4f9e95c91610ecae9f15231a1e985da6b423fd144fa0efad169dd76ff37d62cb9ddee0d71bf4c4db2cd9d982330e3ad6b8cd3f52b32399be3d8522ff151b5245cea8d97e2824752a4b50068fe86a91b123159056474b70a04c4451e05d52fb14caff0dca26e80e5f8eae8052077db03ceb2c06878c8b8b4f21fb696540cc8e744a2c61c3f6d8ef96f9c87a4ebcab7e4fb28fe0dc21b2fe37d79bdf9f9f3e7726998359c98f4e6007aeca6aabae239d58cce1c1a4f69db678af92e61c3d4b1bbcd9533524766b4005f49ddee26008c877211e6ad131f5f1791e8c1517ca9ff43d23dac0385c1d62da3b763e3e3a917a347405c1dc695d2e3eab8cedc2e3c432267e0ee883d6f14c094566a442fc893d0d3d43333b789c84362c52b02bc17ee8faa6b4f9643c1ce967762db60ff3bcf63e8757f4ae1a1f1b452dbce781d84658fcc9e852ac1d89a126558df17eb99747e1b5b8bc2fb634ccccb597cca1a2328977c2fd8bdae4e8800ce3dbfa865c878ac9bdfdf092be4ae3aab279d0a7fd01ec7dee9c775eb53f72dd10d4a32b0df36daf738baf967d56e3b8ef6589eb57bf16cbda7dc0ba63eb5cc4953eb97755e3ffcd359665709f1053746636b95b31dd97e285585cd44a9bc4a6ae3cdbc22424341c6cb6193da3bbd9fa38abc4a1d0f7e2f363d0736c11b9670fdfa1afca98108fc59cd7e2b35f7617e6a2a5edbab64f748863babfb0661073432a5133dbf4edb8781aab55cb735821aab735f31d686c1f958fb08c798492abe2285005d4b14419e67643730cb87925f63a327642e7e1fcae902d9d6afa6cb716534ad677ae9d0e8656c41c830e0d366c7de915f42b1a20a3b722b4ed487428684ff33fcc35a707076388d79b07e30da6b7766f5cc4f01bba12bb5a17d9f18ad9aebb8e80692a1e0f3def3d881fe76df11c7d2ee9f84fc5dcd5e66b260d8f58069b07fd8cac81f7bdb186c017afd0cb25a2f30ce76aa7c4f41cd1355f0aa704d3f4c23ea2ef69fcaf7369efb1f8e0e18cc536ae899d12f257268fa98cc72d92dc1872aec70767ba3a331d0fcffd8c9e1d4ec7fbccf9f5beb17db4acd0fcd6f85aea63ae94e722fdbbb9dd538f65cc1fd9eb86be78c3fba3b4a71c697ce72a924519391df65c18703eeba9130dbbce93322031cdead9847dfce8993de5e0ea230fd30157426fa6feec1971487c3212d649880fb9c87b1116077baaee6dacdb83be03b1ef67591a46ae84f557da379cc5b16f0a6e6e6a2ec4d8c17ea1952fe722d18d088d1c7fb70594c9a2ff6eeab8af496cf61464023d0f3d39387a901fb6eecff858431bbf4f5c66ab22df85c4cace31cd26f395d8d10a6d214f89d065271f1d498ed5e3818c5fed1b907fb2b967d7441d4a4b0bf264eff2b94af3fc91d1fc084a21c7ea9bcbe7f04cd9bdd2c66e4c6bed272bdfc4b44f1b91fe365477267a9967c58b83fdb60ba8be94da42378138c5e96a2047e499d7e5ee303fe2ff50112f5c8d89e56d8be57998e3bd700c9515acf958dcaefd56bf07d97f06c8af4e6fe5db31ecebe2f9a5e6c64ea4c698df505ac2e4fb8cda3b76b37508fb435ca2aaad2a3612b85e2bc3f6a938a5f23b483e92383ed372d0580f61eb9dcd8301bb5ec4a099f4d91fc9d328f6199fdf42f7089681b7027927c41d147962247edcec290c9013d3bca8fd8c03ad23edbeb4f3e9d74a5f83888d4769e40136c72d7a28762242dfe711f197e167aff9d32acfc40b90e1bfadc7e53504d7787b4c710f6c221ecde9a4b6cd1fa667dd0522f1bd945e420c0cbb36f66de974707b346f714aaf4be8c0e25d40bfc3f2ed7acc72d60e86304c5dad9b11dfb2dc6e7f1bed68ae19d6512721c98b2dfacf4cac8f485cae9d54e4db629e9db8d390957ba2f1fe2764073c1d2a4b5c299201b291a50d4253f7f05c4874acde33e109e4bce758d79cec1de984be7a050d00ffb11b8c6d5da73406eb99e4ec91bd1e4099f4aed15b49f49c80cf81de2b6da7d2b8de7e6368f85c773d42173b98fe000d32288f06b9b13d1fb48c852779368aa8b275fb1cddd9e07704d4c6229ac20ce6a556aeeb53b971c5b8d81e01f9869deb22e683adffd30fe540156b793befa4d29ea3612c376ed1a421ad63ead298e2aaec75411ff1c03724fc305d38137abccccce804be83a28f824eb031def8ce29fb2ee2d7e0ed17ac4fceafc7c9065cdc8af4e45d5b23a643bb908b44cf2439af31de1f76c0cb2ae800f2cda7ec05fcf8fcc88ec95ee27926e8d379211f9f01e1beb76ae1455765c7d25f248695fe679299b13d83e5c9cff0d6761bfd259b328b074cb18e717082f1eb5ca479ba4145e66efd0eaac39e0bdf4d503c3bbce4cb32a36e674ee337ccc023b946d3f13bc8562f3bc8e1b323d4b1b4496ae9095ef3a4e2830e463bac1b98c2b00372abc43001c6250601deab3da58be9b9ad6dee666b39a9c4004f57b91b1c3d5b4819fdee98ba9ccd26abdcc0fb245e2027081f6469f8cef48cc25f0b763ffcfc60e3441328fd6a5ef57f7f243f87f8f1996fef95fa7dcb3cf1e111f6760479d1b9a98f7d2cdb1b828f0c617f96a763c89bc47386f7553dc79de5c8143ab7647872b44a0cd8bfcb83a23f1fa81e7a28f68f46edbd6db9387a510e7167e88b4d330fbccc9d2ff9a8da7741571a85b2a8accb3992ab7b5b544aba1594ebc9ec3637f37ff45a99e0faef8b363f620769f8e3a70ac27be586cd179efd904fb2bee6b7f4d57adefb17c80b44aec7ed11ea577889d5fd461aa696b618f0f167adfc008d0fb684deb6755b277f56190a7338406efea1b3da2a1f146312fdc8ee29b1dd5b754cadef15f81c555de4e0c410ffb7375f1e13998b45aa9ec1565ffbd2164e89d10bb3956efaf684a781edb6c4d94b7a211eaaa0e19cdfbd6ed3abfae88bb88cb69cba125720a8f0f78bfb9dec099a4f7aff81b37b09d3c1db4e5bb01cc43e9c876a9c3c91c9e6f9cedbf4d423a5abbe29110c0d66ab62f9d14e0e31f1b12d0d03ac0b94e75205df0196e51d8a7d039580b4017222e0ff25add217c891168919a13759ea2257f20f58b61083312bb78ee5fcc4c9c71cde04a529a252f603e5bb6b6725e87b2bf0cf96b682ea338b932d16b987f09d8ccecef35d50d0515e1fa0f3e7101be6ded0576fd6849f5fe5627f3c3646615fa5f40b6cacf0f7e9cce16c5c58bb76da56b74715783f44670e2a39f7628909248bab18ec4c13ee6cc019e3e9326d5395cf886c37e5fa78ba844d4374e6b9a60c9a38358db82aa28b490839797fe692b89e2bfa3fa73f7988c965074c5fa9acdb6ad314bd9d52e8819c3de082cc4af03eaec99e01c913a67228d8e91adf7e214e847ed7ba5d566db13740ac635d76049bc9b81c930a583bb3abfd9479f7ed6318802cdcd081685f9b1acf3223b011e267c02fd3c08c687faef073125d11de9542fc3799ef724d835aec1a6e1f333b82829c9e9a922a65ab436dad04433b75cd7a5c69fb78d87e69dbd358efc47ad215bff111ebf5be1d873519fb43f107cdb308ba17d3f358ec4e692faec4139632caf57e622647aad4ce48fcd5edb9745599a0eeab863540edf1a26de76e2e5ed98f6a35f6e7c29ea47ae7f1da9efae07c529f678ed72cacee43548943051df7d3b1409fde67836b7141a93c35982f3132b405daf63a49e1dbaec5735d98cb8a9dd70d2ec57335737099cfa3e06593c714f3a832d7bf8b69b850c3ba38da5335c7f202c42b9778101db021b258f012378aea75e5753e878f54b3049fd9c3361f177d2b53e2d3a139bb647ec56eb05df7bdb5be24eb0a393dd416093e3a921747e41c4470c3889f656fc421f38d807d92f08d65234ef233749ccad5a9ab2b3ee84e37f2e5589cac2bb6e4ca11dd2335b5fe0d3a4df8de6c3dfaffc95f9eaec49c2a07277ece5e22b537a3be5026a332bb541b4f9ec7d5b566b20094af97a01a007249597a965b44d688d8682b7946cfcc062c727a3ce7eb21f3b764587e85ee55e4455c9883865df2b62e5089bbafc793824da11687db585bb542531a7bfb27f27d5be9dc35bfc9ace5fd37f25168bc353eab854f5868e4a15c98ef0bfbfce7f252a60689c761d84145fea2dca42f140fa45c9732cfad4e571ab9c1ffebe849f5db6ee4c4bc525ed9b995ef46fc15655bb676a58de4737be0c339fcfa02eb616f9648689ccb302258beeccbae6a67999af6f3a453e2bd54f26a43858df953f81ecb5abc6391ff368e6c7d99d17ea29fa59bec5b4c6de9291ac4de9ef13b9d9ce54c0e33ac1343bcadee1fb16e6b6acb605ece517235178eacfdb9c8af0b2ed029ee6c91bc8c4fe6c87d46166a1fe3d5d84dfedc1219c668e4165c9089dabeb7c8dde164ab7ace462da6b336e7c15868cb37009c5b420b10e8fbd1d253c451509e6bb9fdacb3382cce8650fa60393b47ae9ee70279fe923df72a9e6773bc8759c074b22517bbc4630597be637e9d618cdcef0b7e1fdeaed0ee67817818d0ef4add82543e67ed2bb8afc40ffc090cdc0b7ea90a16eebac51703be39f2fd8067eba1d0d2171d4b1bbcb9e238b0852196130abfa5d6edb2588732e697d3ef010f76fa5cf57973f1369636cc08d6e902fe76f2123b961bc725ec4c3667ed7ca67d0e7eb1cfecda382ed820d8b81aba9d99413e5e34385fc0016c7f4f8963f04270222747473ae13d518b5328b195b16c668a632c631439428c3e436c506903a2710aa66f4f55d488596f392b1c4d6c9db719d5496fe987863618ccd6458ed7c7f35bdbc754e4b9323fe6c5fca10bcf7f32e7b52d8708e61de2479faa31ca4eb72d27a8cc7d6dc6b5346d0f97c64ffd29752cc6f4eade2a62992fedd9363bc44fd8c482ffccbdd8b055f0f63186bbd8c86bbf3067049ff1764e6dcde756f2cd4bfdb6c5548f86eccc57fc6a17d7739538c083288f603ebeeb7baae2bf9887651f33b1817bf6c3fd55f4dda901b96fb3e071f66b784165ac3f4bef395e3922fef636ccdbf66fff7d36bc0b6b7d95a6ac43368e5bf6621ae70035281e408f3bb6da752fcdd9ff0d763c8ee67d40efa6eb41f29c4047a276a486ede4737b7edad0d5ae6368566d558dbc76bcd68019757d9d99cc98c85fc03676d946452a207f3575a799cf7745b6fe84edae2273d3b8cd8e85cfd4972766db08b8f8fcabb684cfec7725366eda10ea38b875dd8ccf4fbcb4672bf9c1cdf5ff09dc98cfd19616fbe2d53d58b51d533c85667ccba5396fccc7afc8c377992d42afebf8b558e3e9d29beb357a52d5d1abf991c4069632fb05fd66c069e665799ae79810bcbed3007222a5618fe443b4ec6fc8b120319ebf761dff876d7657e5a1269dbb692f2f6de4554cbca9d18e2fc59dc50a165ed16ed4860b55c136fc0826541507afb401de8a4dbcc20b5aec9a458c2b32c5113201ab6dd1b5217789e22f027e1ae037a3ed744cf3cfe494e27009b375d3deddf42d54f74c0b7daadaccd63f8101f239bda0e59c763f80357ac3c7707dafd6bef9d7f9199af152adf18de51c0417cefc8fd8ee7a652e455da6277e8f6b319130c632feb16acbfb2d95b28b8a6c0e0ab6f1fe03f519f9864529da5e67d8f9b1c28c8f83de40e8deffeac28c83df5698f1b173ff78df13ee3f5c98917ee1870b333ede0b0ff7c29532b4ade5bad7fc02b0c28c3de1efc28c7f1766fc4c6146fe74ff5d91f1672a32ae7a8aef481eab0047b891a446963640b2a8b0acd283a50d12967947d14320eb8bf39ab0b68032ed8ae39d2d40761bc98e83b64cc2018415189ffc44abac908ccdaed15b12142a9aa5c3a25b3197054ea58f21c2fb4543318dfc2551fa8008bb78b324756f90f5a1e85a6c9ce0c9797322d527597b47cfa67d50d412ccc142271a1e69c4c819afa1ad3d81a778232c76a6d6f56531d95b9a9b43c54044df77a9225f59a92763957a5ea26158583d96a5d661073c6276734e21aa55a216696681a051e164bf809492552af430e900242f8a0222f5b9a858e6f5ef3733ed2f7e532532b9d81f655678a50261508b2cfe68c4f3ad7e0fb340f6ca4a9b1f8b7ebe96eda1881fcbf628aa60456aaf3c038bdcd4485549633dce4cdde1f61d87d05066c3133406010920b553b487d23a38fe6aeaee173a073b7cdf6155afa6abc416fa892c26ec3e3d2f26ad94cadeb74f6dc1b997a5d3c1cc074b12e97fcae6702e87efce79e729917fb085fd19d057a412a945619e83de1ee604f73d8f68c650fc4cc743331135767e4926229b93ad40c771de79f879d20fcd148d5066e9cb2a3aa844fb19016208d312deb8ef04c43278ae8830fe005dd09eb3e2ec7ee91467de5c0ff01cbfd033be33f241e844a081242d59193504933e4330f94a903ae15d9e2c8e3cfcbe967336949f06c8edb1335aaf2cd5c1ef67c816cdf7c78dea0397e874506a113f523d17247ade5b1f5892ea336d83f699d8faf8e0c44b6f36da297284f7953c13bd30a09e81f6bed7c3825ecab4f2e68d7964c80a59a971ab635b423edc2faa89f5c10a8579444d333fb0fbc53836ab84686d72337bcb0b03bc274de131b3b447e08d73a1c886a115794b04203d3f7a4a912148d180b8ca5c6b9a81e4e445d52a3ade22abb01807a1ada4ddacb53a313b5b43c88ae2d6a2fd7c3c910cbbb5a4faf694658f7d2053b3867264070ce58821db50fa59f5c8be51cd98e739d9926410caa636490b4fda25ab4e230a9c56126bd0e02a5a565989708c20234852197a99ef4a93c0d0178864e20c9e96c1f8ec48ea9ba59909a6bf1025071ecb554ead9019f05dccff49a63fa1cdd2a463ac4bb9cf86b37cf40c388395a8be1abd9f745cb06833841ed499d34a0684760f7c5b1c1f0d5df16d4d25d9ae127857039a1182e5494023e03295c14ac8d0ccc89e2878c53707aa42d0ef216b91d2b97821e357a96cb1e8603e00b24fc173ea7cc5fbce68781d19b44475afbccbe310053904974a25dd27667d70f25130174740a7be7a8cdf7e2c2bade01fc2f0686afd5a761a3b6f84765eca5023d6de8ba882d4d351472e84aa2c247b0daf2bfbfeb79da7e84fc11ce446a8800bf33cab56b0d86cc56a763aa9eebbc2e7ed4c64398af4c4be8fa2403953e5e04678accb327a96a2513839438182ccc694ca8b1d4c5b1d61c2902f70bb375be8ee2d7dd5c1fd40c44e344ca14aa9a4f8869096913bd2b33763bc490c3d757addf23f1393cdb6818889228ab2f44e78cf73664e15b0a6cd03efbb3bf59f189ffd9a8f0f567eb9fac9b54c51439864e03d2a2add3e41b5673b5ef80459a84f642d09652df70286840d9eeb92a665a6ee6eb62c7b861bfb0f556eb961f5757a2bdf8989e5dfa8afd95b030113efd7373372805772720296bd22b3945bbc6feb71673be2642ad1dc6ca9551c900089670b7e17285a12eee3d894a32ff1661a75c0eb2b5f039057d975ca8f56c5fd19bc234c8b4adf9c4ef1ca57ae23884d8759305618629953464c14df599cc5e9b3a74e7d4657cfb2e8132bf564b17ac1fb1374dae4c319ebeed43f147db544357ea89a7930f26488f6b94c83da322b01b91490d39c1a2227deffe3d0d494ae99770b9427f9097594de338f9e199bfa12d0bb8c688869716a0b6ecc3cd5f27471343596153b3c9aba52a25b30b4ab42961f3c91f7954851780fcfb05e2ca97d5e8f7d16c34a850582ee42a3b0c402f9a98a1c8caf5da039740ea07a6ba15f15d947cff8fd3047b548aeb71f3c27a5353c5c212356e3194fa7c4f1db1ce4dbdfa5df000dff624bb04e6ff06f91d55aca301c8a20d033227b605e62523440d81b10a9452adb908aa01c7db948db97c2e9e0089b5d2d8aaf44692ee72b2bda963c4dc5fa84a1ad426667b175b563e8649c96343c583d92ad6a806dad828654f039acef02b23ce8c0a42233796615ca12d14d890cf5c4559b7e2ef92420c99ed2afc1b8bc0632eb2653f5059a89584745defc92bc3c51cfe6da27fa30918364fc37af5fdea487adfade00cfcf8ece03cb5a6de8741435af8c32142b19c12c02af8a5612ffecbcd6a21a4954af5fa50f6c9f60996492da5f769e515c23df3f0ff9717bf08d0657d1cdc8cbf33b5b8fb92ccee5ceaa21b4cb789dc2eab87ec11a5c7d278be2add2e112ad94d869a85c3a7d86f7dadaa463083e8f4e12943269d33ec5e96ff5ccfdd27e55d3bd4a39b87cf76db9f683d9fbb0dfba5d97201e607d3e3519b268b19796d90b39cb98267011649b8bb484b647572335a50e6b47f50c5f23d9d335f9b1ccc6d913f9b193cda6103d9ffc88ae4aab4815f6cdf2dd9cdce25533148d7cd0906de6119b2f261376daec433fa84fd423a7d4cc12060757e857e82841291821153cf81d220b168820d76dcbe4bb2bbcab5536e3b2220a5b338bce32eaf667b2479af6ea29b3bb5791150a9db889a850f0e602e574aa2082e20b7d57a384748a742fa9278294f3ecc9d133e890dcb3956ae0caba9f3bb9ccd384479944f3e5ecb95a35cf4286ad8ebba2eb7f6c3ef8cadc45660543e6aed8781befc3678be9fd25ca42e12361e80ac59ea5688fdcda55aad4bfbdb6f3ec4a559e4bbc9b8c9ff1e145c7d016efd49631069b8574247c85f0d1682e8ee3b938decdb49167ea93aea19d28ff2ee6a8862047fa9e49c6ceccfddd4c0c93d9bac1af9b73b41ef3fc153244d6f8ef9c5630c90794a737cf7305b1537ace188f6ef28fa357e1df8c5fc3b7523e8d75f932caf42abf2673d9e0bd67b015517ebd5c137986ce59857f99c1d133f3a36789c8c3e7c0d52629e5dd5ec3ded9c2ffccc08f6792b79badc364a68daab4bcb187a0ba12c7dffdc637c19c57782ad3ff9f0b14b716fb6025e3c6c84b74f7d6f96fe5d9a5fc4c7c79f50a06352418ea9b217ad093d77c0f87d279734ea89f67ed15e8b198a69271a8b52aa0846f401615f031defe5fde2bc7e1bf71e8fdde4baff8bea4e5bc67f3908e6553a2dbf3e79eef8beee37bac3fadc9dfbb19d6bbcb737f2f4be5985e1b73ac8aae767aafd1c27a961ce75b25eb604b6959f5ab6dce291a0f2f3336e95de82d7b0ac2f2c53256532bf7b94a105866ef973e4cdaae7c4f9135c0cd171ed7beba568dca0b655ff0ceceed39e67cadf7bc9fbb427f41b7abee573b1877d9752edb0fecbfcd79bb3d57aa3e0e411613b96f47ead194f8bd42abed73741fe4db48ed5d939730bd64df3f17c7df9c09ed771db6ce211b4b2b7a7bb5afc22769e4440773722f998be3bdabaf7c66172bc73b1872f4987e1b44e37376d649264fd9f81a7bb5e27fa5999a830ff11d582bf59b1d6d4a14cdbcea4b6fdd7f881b676d1d4ac43dbc4f2f54ef5da2722e4a5b25a3418be25ed8ea534ce5a7e1b1580f618048465a5931c12d2a26f0e32fabdf5024ff52c7982e8a8ab4bf6c1fc11a862db64814d26a1019adbcc1fcb659393f9571507e82c2798192a77088c710e95bf936caab12536c4148fe080ae4b5fd2f0c7edf19e0d001f13baf556ee4c783e7eda374a82ac7a24a84fa2d3a44df555642c663ac54fcffd819f905fc943fbb29cddc067d41161512971270b2a2a8282b12ef84c79ecc2a991dff8d6b7ca19ae04a525313aabc547872686a27f4ab78097b478597842b644beaafe7254fb4df0bbc848de53fe11c15638168fbab32115d0f90892ef09cdb67897f5f8940fdcc47caf332362f9b3e91f7e33d5f934fab760fbe9db212ab747f1ea227ea2fa395aed91ec0e7143dfdec1cc8125423e940e6009f4977510eafbe7f86f94311ff56a9f4fad1b9a8561d929e336eef67aae02387f1fb163d6b25fa8929124494f2dc0d387e3d484c11784e6202624ca1a3643ccfb27b23ac1f9567017fdf05bac3ef09ee4c14df41109f6b91fa202f519481ea9a35aaacd1b56bdac36ecb484595116eeeeb99ed249ea0f4535e6857af7a5691b94bbfa668d2e70a04c407e02f2cc306d369fc9b7bff2ba9a8c5f44fa21bd5f4cd99c8f92c196d603185b04fbabe2b2d762a17b333e36c50a6847c9265f1889cbc40852daa4f9098dac7ef355f63528b57fc806df8466ca3d42fdad5fdaf4d39a6ccde6536703b18c886bec0e7a6a83ccc65e14e4dbd56f1b92516f5f367dd45d72a73ce45dc4fb14f2b158067ebd1ae853e36b2644b1fdd3357a962a05b929a9b54066edaae08dd99adc30c2aef1719416536d54a506bd55ff1b77c2a5bead2599b9afad52aafa9fc31da58a7870723daa59558afb05807e8f327518b70ff2dd94fc4e66049ea9bd5f191a19d2056d68198ad47bcc77c531cfbb264264ebce85cdb6773717cb084c900f4aaa712896bcec7e2509f079d77b20e71e8d9bdd50eec4734c3d6a215063f64ab68897fbb183f53d987e895cf483372ae5a53784a6c0d75665f9e92793849ed6b9540c551a404237fad2fca4c4691acc34790578b580c52c198da4cbabe1da36f7608b437b5b44507ce7163febba54d4e382586b0f9b06de7459b64dbcd90c6749e12a3b7bc8a6e600bca77a8d6be6eee61d6e79ca05fe5c69aaf0685623b826c72404caaf9bf3b5bbe1a2b2f57462c5e70c0e283ae55626d9be3f39a56f5c1ebfb3c22f144f01dddf4a8af9bf242156561a1d00a6643f9cb13c928d78a0a6c1c52222f9f8c58252e52e5a8bb6763b8d7219e69703675a553a068f526e93c449923a839abccd3a07b68e51bd109cdd698069fbab63638cfa4b2121cf57583cea1e7649d28f237f15749c3c08a9fa94e370cac6050f8ceb09c4eabf1a7aea6121e3bd9bb558497ee03d012f531253e6bea9b1594eff388ee5715aeef0a1a352de7eedb7a746459e4e57b389f0045b0812a36bd313272e029d45759667196d50469d537fe1bc9b8b86a741f7b1f436c54a1dda65a618466f0c238f4e784c4a0c1b5b7621d9ac80d75b45566cf5d18da60f02290986d5b426faed8eea3f91a8c8b7586f7452ea6c999dd5b527fb8c7a3302476bc400e546ca173a63fefd877339f3bc4dd45931cd0b5f3f1d9d18ed986a20ae173e280cf74929bbd67564d85edd3923fd3783c4b733382ec0ae70e3f0b2854e4bcbbe739521096870c61b383fd2d8e82f566f0b252958dfc84beaa4f8f41eddaf4251cae57ebb1f28256dfe4e0e83daf1952cee26413b46dfcefbb2cca9e1c8ca082b53b557c9e1ee07143c535dd4746ce1072f073a4a2275e6f25c6df7f015585f15634cc6de194d22a31fd39520eb6740af01acd18c2156b73b5e204b70fe07dd41f1d60393df56474cae8b714fdb118c699d882f6d88e9e452a0a43bce992c60b2595f8795641d70ef8eaaf75b43bd2c65c13596e8e88ce52e626d1f6ba1253bb20d587bbf85d640dd6f4bea824a628d37bec998e2747102714347cc13157e5b440ab011b11e5c73296f10bc43f56c575b6a6d5d972aaffe85564c04ab55782fac4a1c0b1eac1bc1cb38a6905ee3679fab6afaa5ead95fcf69dc8b96937305ad1fc69aecd15db0843db226be2515d75436d7dcdd8834a0c5d7eb9722b431874a409d647860495f0797709cd1a907dd4a24a4d91dd5eda822972dbe4b1866e51d5f5ca6a2babac516108d3a77c14ac609da0d2b027872804d91ceb7e640c41a1cb2d7fb8faca812014b565e8b3fe6ec5b98715fdaebc569c57402e510b94e9c6192faad752bd317518c2277dff3cdfb18a28a12960b98fe45acdc5718f542aded03dce57e6ee427c2396e59de938b785c99b25f6590e6a25ae939d7d8825c56357dd9da92bc40e56c6c024a658a52b2ce70beb3c459c48d376036b6269cbb62ad1d4de83d7f966db643ee56cb46b3a56bd7606c5b0259fb0c546f3c4622e4a1b4d35f783da383fa08f94fedeb1ad6f70bf69d5e7240d734a07baf686be97fd56594527166747ef53d9f862ec44750d0b7f3f69bfb0bf4620b7d06f08ef01c185c699ccd402f530ab23aeacd9f3a8538ba93c9d5bdefb36a7f98d2d710817f33aeb36a02b6b508f89f8b1980a88fff0122cdfd6e2285a7c82786d1ab123744d18ca23d5d30bd4c766ec4bd5d65154cda736b0625d984d2ca9ffd619e2e4d5581352a5e3e36b746dbc2d31b3b7e658aac4eb5db219ed39db479beff443f189f370789c89219ffff4cd613a33a086977ae0acb47136e245daf920d655c9fe05bb278b9512c3948fd5f81a8cf798de6c848960963628826ec4f97cab718945a5f596d8809f8e23c1fcfbc5d04d5420795184f49a0feebeb21f0b79e177f9d43ac91c2d7c37da14e89ee59a31df13f809393fc1e531fe7a7f542799876a1febad6c7c5554a946258d5b88f05d3bda6776cfcddaec64bcbfceee8dee65513d615dc75e0fbe39a29714396c15df6313f907f3ffe5ba5249ad6349930e97d3787023ac47a0d024d53533cc73089ad3ea0028d4b4225c5191b2a8765b224e97b97104e7e2124ec5451c8246cce7fffff72215b98185b6cefedfe977f46f3bff006251db030cb948e83cdcdf042e7a7ce9deff253cfc3578f873782f08dddefdfde0d70117090fbdfb875e4ff8c5c8453d861234e8f6ee07bdc761ff2272d163bffff8f0502011b14fbc885c546fdfeb77060f83de15e4a2e633dd87ce7d67f850201891856000468f7fe317fd8d5ff419fca2b6b3fe378ed16fc431aa57e8a4554487674b733303f8ea26a0d57c48ae3ebe1f1259d3d0c7471b6255c14f479e853cfaf1a14045ef4dd20a0268816f44781da95a40aa39cec484d83ccb2a0a09f57d315b2ef18141ae42c54f5f56830cc6891d517d5a27a8b0543f21b6f8608cb6123abbd3e7722df85c046853e42234de3b9b123b7f8919107aa4adcf7c29f54a84803d5162fa8c6d56fd52273ede5d59459ff85e885fe383d8130d4c10a213aa12a006df5fd219d568925ada8adab0fbed39c8200bfac810522e6f9d7e4f77df31342535555ae9599cb4e4ac37be8ff32955ed2d35998d93d1e87c5339cc25df95f0d5bbebef33a85fa7b246d46759d89045c0a278b372e627e8274d14e326de01cb472ade81c7456367613ed623e4ace5b4dc4bcd31d7713bc4c2ded1a9235e86a6662676b4a9d8c2c05e2f90f33317e09bd919a595cc5711cb7dda088b83236d3272ce281604db77f9e83bf485caf3c97c2574dd89cf957e2bf5e12227f0cfccdf45e47c22177395eda3aa6fab905b1fdaf052e83a4457f050cef3701232e4d99968621d3431a9aec7aa48c1585e769e49d176bf36f7159e5392432c2e2fe1e46099bf27d33e667a2729f057f0dec80794062a8319f82d887e6e12f4d3a8449245ac9a71432f307aead9950019393185818ff782b926f1887cd532a02b34c7b9ccd9bfb62768f5db1edfafccb03d28dde52ac96c081d27f4cb6bdb0b45a59c7acee40d3e42abb60d7d272fde4f68fcf4d99bc5306f2f25c27ce8311ad13883648ec74eee4776e09fb15e0dfec1228eeb47ce0c9d9f0b67a6a0b168f166f7c6684ef91c1d3fc54b80eaab3406bad61e55ee7de4acb1f9f5389a9f98c1288418a327ea432d708608ed2df0cc307d09c9355397774e3ed8000d22368b1dd98b35a4774abf67f44cfeb233476cbe2436e66dd78af56144907b1ace43663ba9fb5447c8cd651a5f83864053f8bd20ca417916ebb9a68d3d53f1799951df93c32e32b43ee8bc4bdd8c89de4bed6fd4266d177995cc66edd37e9a6be2e4832fc53c9267981f2098050a6f1348dae8c16fd1b37756125c57a8a105d3a08785fe2c74fb0ffdc77eb7dfadabd1dd7f76faff147a2fdd87bf84fe5f1da1a13c13a5e39aeedcbd27ea23d19d7b15d0dffb5fa53af7fb83c1c3103f4c417f3b43a13becf4871754e73640de3695b9a5df8707e141b8a6325f06fb1d728a72e7bf4b51aeebc1b79535f6b3a91d375f9384de9d6bbd1f83f8dfd67b74df277dfd69957af4f5a15115fb5f9787f57f0ad59becb5aae66d670172ff4bfef25f519046d017a78affeb0f2b49fef476b88f8f2ae4f0ccbff7db744f1ffcbf583707725128e1e4db7f0fd9ba8bac20fe338fd007ac82d5a68c9a3d74fa57cc8137e9d84fa2973ffc5a1be0a0b0b7f53bf78fc3c7c741e73710b2ce63ff41f8142183c9fe0f32f951be76c1de47effe6decfb8f2028b5a35edaf8e6ebce5e8c8647531bf88e14decb5f9eb367b17f12b11e1f3dde8bc1c87325d401db06f5edf0fe9b95a4eeed5c9ec9e23834f5c59b13a123f325c9015c174c5d39131fe1e8347f1b65cfa3e46cea0a5cffeaedf632d6cdbfecbc798f54a7e3e2ab8e86beda39e7dd4c1647078be2897ef508c6ad29a81df6b72c8e3b86e69ecbdfa3bd2cd1d8ed51d1e6ec6addbda12b83afc178bc790a3dd62793d3096edac8ab60938528fc1a8c772496da27b6a963d1670543b3fa7e98a77b99e0c6cce875fc4c82e787c6d7f0d719d6f77d310efa6dd4f658e887e53d3c563573b54eed19fcfe13d8d94c7d716edc9b2ac8d056891da967a7fc96dcd417475b189c4dfdb9d2dff3dbe8c87d1b997f493d1b3d25a1989df7f264d1358405727acfb5f191d8693b5edecbd3fd90ef77f165d4b9d9ef94b703113b09e0bb2cabcf511f7be67ed979db5e676608c3bd230d33c0a2823dc4e67752acd312eb489b1532a349d79eaed695ef26eb760fb1c291fae68e8a67c24da49e5c4d3dbb1305d1fd09f75c9aef630bca77ac9f977d8d73acb33a3154e2adccadad2fe2fa1cb07e989e2e7ab72b9a10aabefdff9280fae70d4934c5bffecbdd26dbd8ddc64efed77f711fe25ba91f38bbf7e46efbfe7e7cb7929b62eabffeb0f3fd36fde31f7f6cdfdf77eff88fd768ff4745c22a7bf576ff8c32b40fa06db5519abc767b778720d9e21bc1ee2ed865fb000b4ebbf40f42dcef5e03b4052aff8f3fd2fd7b107bf84e9ac70e1e126167fffac3ce5e83dd1fff28c6e5eca2e47d9ba677af98c2f317bc7390c0ef786f05f1f6fd0e05e99e5ed89ee0aff73cd9ef8a3fee2cd223f9e104890f83a5bf5dfea69b5ae58fad53fde90a834177c85d402848f681535ef1238bfb15c4fbed7b6ca13b7b87bffae28d3bdb0eaedc4d5b6f6edda3f5eea6b521b5debe7b0db6c86d691407e97edb366072e3ee35b0f62d77dfadd8a58b5cbf9566f61e6dcb1b913b287fe0e7b85f4e9ffbc14f74ea5bddca2f61705ff93de80adcefda2bf7885bcfd3a033acfe2ac71a59ceaed6f42e0983133e1491bdc543ddc6cece254bc7febcb3d2b8cbffb6ad747bdfaf5c0962eb3de7af38e981ffe96f4ffccf377cc4b9dfc936aa1c4c64796de7f3358d77fbe0352ffeb8747c4ba2f0a3a7bb6ce43be8ca2df8cf4a2f1210d624b1ded3edb5779056efb046b79aa58e15c71f68b67f7776f1e156b3fd2edcc6d71ae155fac00740b30f0c0ddab5bc34b2bcc0d9c556f07e97bcef92edfb3e0002c53709f68ebf45c8bf8bac24ddbf67ce3e7bdf56db245b84b6784df1f2ee77116a23dbd6ebf67d77f1c65d04fbb071d3692c34b9fe76b4d07efb7edc5a7b7ffb1e59715ba384eee68b2c84bf91d9bb34d8efeebcdd7e4b567087acd8fb73f7eedd9deed23cbdcb6238af95eb9809dceddfad387dddbd47ad77b3387076eef62e660d92d0fb3388ef8238f8f3d0e5afe45684fe3c607283978ffe73e7bc3b3db8b68789c5ff14ca0866838cc45876c0ffc4bccd421e7fc94932fee76bb44f77ef7bfe92b7b3dec1f0c45d71b776e6552f6d4fc9f63d88b0d054b90e24aef84dfea85cd9ef2cfe77bcddefdf2da7f219bb94d186e252b24388ff5d7fe47dfb8ab6ce1e05d50949add72d270e94d783d843db5714787e65fc699e3a164277dbd3d6215ba0718b6e81e2fa7e9beed1ae323b598cdfeb6f2d974a294454794d6b220b792c2263c3ffdc11c64cffdca7ec6fcac9a220dad27fee80842616ac1d5cf89eedf65b1768996503838ab77bf2ff3b7fbf4fb83fe17f6cd68b8b6cf86dd7eeacd409027a27deee03d61ddedec9fb0ea41ffc3b7bafc8622d22195d29f8cbdb9e92e28fbb348ff7169edbf72cde934fa57fdd39de8efb558e6abf8b401e6adca193dab89ee6200c923d5f92ea9a9c48fe29bba76bffc73ffe2087ddb28fef016119e44279162bbf09fdc657c9e7503a50fe7597ed5fbbf7d5df8ff013efa03ffef1c7611bbb3b4c543992c2044bdf727c4be87cac55b24379b7d719dc680dff60baf1d1764c3eb9d6387b3f6c99e0f8a176e57a3151f2ca637ee8be5e6fc153910f4c4253ba6c6b8c77bb1ba7f8bf689ba69677ad6171eabc0c4ef5cd76c9fbee94df6828dcf989e584575a056e6c5db9fdbecb2e2e34ece374eb64efdb3b3b7083f7ece24c3418e0c5466c97e30e3fd20ef8e5fff9219fc6bf4a41f647a5cfc8daef63dc20fd8ef948af261decdfb77bc77f07828fc5602b4db7ef75c9a4de28dac102c1cfd8c3a3fd4fb68efe3fff2f000000ffff010000ffffc6f01a6c62380200`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7d5973ab3ab6ff77f1eb3f673338ce0ea9ba0f36b1319e12b0019b5b5da7c4602008c4013ca5eb7cf77f2d061b3b9eb27b773ff4e5e1ec58d24f42c3d2d2d21a8efed9f0c225491a2fff6c385eeaae8c1f2609a8c445a1817694430cb483b2572f6ebc34a89890940a88b5c276e3a121061189d37794ba8d97cbb51f1a1314d8802892afc46cbc341a0f8d198a1d3bcd7fcb84a45fbf3046a9e9365efeb7f1a3f18f87c63445d86ebc2c114eec2225db282161de84407a1eb61380a328fae190c643c324e1d273f2dfae8d70eae6bfb1b7b4cd9d89ed22491cc78ef3df819dc69e99e489c436633bcd7fa7bbc8b6f29f6b843d0ba559ed7f9413917dd9d8a576927f38b5b769e3a1618726b1bcd0a10c94d84f8fd59c0fe8fb43c38e631243a56500152a33b94c42927acbddfec771b18b12d733491c510ef92358e1d4cb9a3a06055e6aba36c62e15a02849e39599ae62fb1813c524b053d75e2594893d3b4cff740846a15329f87685eca79ba651e3e1227150c448ec788d0c0f7be9c9e09268c934a9b517d93114901f24b2c3d4c6362cd0ee87472892da984a636466a321c4c99613baf183c40ee5c4914999c4cad6e342714e13d9ef3ff3df7fae99cbf024456936151e508147a825243071a80413a7f1d0086d58c1d04ea962e4040024a112cf09116e3c3422d82ef91f6ae961bb48c7f612db26548e6dc7dec2a42524867492c62609d7f92f2f74a0c164179ac51f0aa524f0f25462220cdf48bdc0860df36a473951ae965987f7c41940fb2609a2d84e126a89516a57339c4fef08f0893da3a069e485764c612f498f88dc8c77514af63f2864278784e945ae1d1fd256b5d04ad021619b967b943a2ab4d8568be12a19187b51ea99879ca51725cc237dc8707d6b594905a8027623df3ea4bc30b5e31061ca20b1173a170b28c3f0ae9426670b4d1226290ad36c61be16db611a936847ad991ff40ffa0ce0cbb84e4b8e27fc5c29e598c13504f6d0b5160ccf0988750560bab6e95f29b762c3b9527cbcf2e78a1374adfc9436ce203628b692efc0a8a567e36b633ea6aeafc547e4f6a538c0d7c71460dfbeb664a197a4f6b50fe4006ae9a1f40a2abeda89c4456cebe93aa079bdb8c5b0d7002b23c5f615408a93ab0d40f9951e98c874af346fd95142015f24b165c7377066b4ba817088651bab2b849ea12eb0810202a7fae55212e2dd99522f88f099ec1885e70818b257a977ae46b24b8e2b0556ab9238a6d913123dae189b8f878a475496b888394a1d91d831459d12d029bda4b8c2b6529c7c99b023c0b64557763fa4a8c8f7b68d8786855204121a95fc85292bf6d6767c9a5bb60c225c60d8564594ab4a752809996a1a5a6db2d72541c30b51bcabe698c9ba9a74eded39c9b19a3eeadfd982acda122327396deb1842a2f40662e3c5f617c447b297078e0bd647a38feca09adc06b82a03dbdb688d60eaa19fe764621407240481374696b73d2e336c12873fa9c88efd84fa6b85c2d4c327a2ae69873ec2a94719c8f4c97249ad1f4f014984629bda6e6123526bf6b8d8c628493d133a90ec12b83cdd2a3fcc5c6c3b5e92c6bb9b35a298ac3dcb8e13ca42f1c60bbf51017be16afb0d7ce2a2d8b6be5161e38516d924376bc07529f9d675c6217f60e2c414fc73b9845aae42f34279925a5f4a40eea7562bef648c20f2ff619b24d925a95d241d94da1bb4a3d6ec61cd408e8f57d8fe56ed7815163cfefe3ac08fbdd4b3934b773c3b8e37318a2e155fb9021e40ae89af1465ffa124bd0589509cd8d7be913514c5d924de8225260ac33b60fb6bd055584a7c3bbc06022675c70032d81d5dcb70673efa41ecc4451bead292f818ad9288242955deb26e9553cbc4be897157cb257d13b5276e335a7de560d72a24218a627b79b3c267925a77810e4de7fcf6b852801ccf2421f2626040911d7fdd1ff7a837821550d827e510b44a49683bc7c5918db10d1b07f8484a82932d12f90eb53f9eeed181ec87540117458e65914c3550485fbfb339cab59165c777b67a28f876857d8f7e974ee86bcd805836a61c721947021003ec6df44540f88aca5abb088a62622e2fcf425e7c58856f40bf2ef237b55f6869c7e4620115d8c1b942f30b0bcff33f3608a776bcb151eada71804e58560e8a0ab9ebb226ae52b03248e2a58472486ae7bc99fc2844811f26a150149ccb83fc5c317bb6743f7d00c36455de546e20f7b2e7759c4b92f4ae06abbbf42a30d7fc5dc704b996ef3ae8a0dfbb8e5bdb71e291f03a2e9fe0db03f088e9a21b6d45be9333c0dbcdc1088cd57269c7d7711b0f5b268aadb3a8fd7efd5292a4c8f40f2adfd3e234466102baf82fa54b94a4c5a5e9922af99a9619a569ec19abd4be063290e320e72ae4a08ebe00b0b7d07d10cc498aa3ec9fca70bf57e730db5913d9e42c491cfc525bfb5f20b7feeb2decfbf61b9ba2ec705de52bbfa75140fdfe5663bbb8075e6ab044de83b98f3cf7e83be8748f753031ae770252de558a8094675299cec4b2ad6b5090f29083d23d7b3b0f4b2cff4631e58560eb0aec30bda7b5c3e4eccfb13bb0db5bc8d84ec82abebe7ba1c59b5b3cb103b8fd506be607fbf483be06bdd95606b86b3572644848740115c524052c8e289360306091b8a8b566eea9930971f740cb99bcabdda31e1c6e670763de962a4d749930eeac327dd759007bb180722364fae78a3d2b4467f34bc201e54062c7c565e6147658c14a41b24ba855e86d4ff341e8a112db5cc53665789657ea2abe608ed9ff69e92af4e06cca9ab80a08cb16be1848ed305f82bc08455e42a1c8cbe6ca20d6eeee4a6068b5e3d8b253e4e1e45bd5f6f6d93355ca63eb5cc181815eae5c2a0caf01300a4d3bbe8dc834c077c0e0bbd8c886750f3cf24c7fe9c5497a073626abd08a89e18557c099561accdbf09d3ff7c9ab36721027431b7ffeb24dde2461689ba9b72e2e451750b16dd961ea219cdc05820321db2597d1a54afa36a2b490df8265447a05b78decd8cbce285070a134b98c8586cb9bc635c09ec9fc36a787db0d9688db5ba4822ce8d00166b75ce164038a9c6fd4c40459f7c04ba2bd077bb8b85c07dea6f103f41e3abd20c15ec7dea4882364e132720f747fcbbb0ef52c6cdf010bec148171ed0e6814db69babb0308d2005edbf137a0941526df84ef73bf532f424992ba315939ee77aaed8ff6eb78d01779a67d378ddc602a47b855724f837b27a35bc8e3bbf89d5810933624f6c17874b99e6fdb11c2defa0aa4508d5d2abc4592917d8db8ca55bb8db84e7577aee68d45bcb576293a7f54658793b15a9e1c57968dbde03b15f66a953bf17b35dd9df8c2d67c05bfa723cb4ecc421f7c27ba3075dfd7785a3838dc83b62d0fee9d96bd442b7cf737f623b7ece51ae1efd6babd145feb04769220c74eecf4bb3553e47cbb8abdbdfb337b93cb1d60f0a484c5ff06fc228b39032f5c26eec13a76e8dd4b2385d3ce3dd0d23de91e6c142327407782ef5ff724bd7b39aa1aea8be8cbc2f131045928bad1c7c27b36c71f5c69efaf71f0cbb8522537eae719deb2bca3df89bfb9d4408f09e58764135228dc45c6fd706b15674aaeefd4c97c1b0394f8dfa9042a3533fd4e0d9881244541f49d4ae0e610d97152d4897ce787070e48de8f3553cdd9a100ff588386a6301dc31f0a59d88e9b652e65c6e621b1ccb47b2ed9d869fa03742d51e1caeca699d917fe50a91d44854bf49ea291e11d25131456d38697e42477c8d9a536c2ce6956b98ff799a68b4c173d1727cb219baced18393615a726591f9544ab6ab2f421c7de718797415a3890efb31c8262d33dce29dd154fb392e3bcc34df5389f1ce182935909edb4d465edf348527a43ecb32282f1513a2630aad836497c3429a76d159bf874e8e5c6db7bc67f29319d98aca27325f6d64b5d42fc7365ced9b61c33f3133957041e09e7f353f75c7e14c564496164d8f85c71b23bdb5a2192ef3dbff68004ccc71e39caf24207db4bec39ee11691c820caa59106d703ab9c53df2289ddac9716b458fecad6ddae1fa5c5171dfd9e74313f965f69005cb9dffbb66ab05ab1046b6f776388ac5f008e591e202ebe5de4779b327aa914acc46f9f35cf119dd40b1a8c51ac21f2a8f08287ea66569e97cbbff9dfb1206b96318fcc9bd8322946dd32ce3af15496d2bf39c4206b6cf4594943fb374b9bd8e328be1eff32ae3fa9247a1c4f4bcb325a5f6fb7c49aebbbf589c2cd7455968a75ed96f10fdcb631fca56313e172343126a95fb67dd1f2d9387cd0039a6087641b13b0ebf28d3219554c9f8ca7411f655c9c97663255dce75823dd34efeb5001d50b9a7c8d8c4058dc2c454cf9da374eeae56c6f53c340a55fce117b54a97ccd371fa394ffeb5ca6bc08e811fb91fe4da0e2d1253475afec2273a3f8f58fa3e5444f08e69d2ad1be8ac69f0b2bf17573a515f01efc9ad0c5db9077ba3bf4093569850569814d7a22bc053f3d14d5c1493edee06f06055ba842a8c4be78ac14694c7289c2bbd6029ba08ad1a8c2e824eec46377185f96863231f22d46676921ec76dfe0927413578b3925128ab0f19fb30ce0a288fe5ac64143bbb92934775563240f8b42ae932be739f5574b512e87926c0b3b87d9ff315bccb4d3740699a79b6277fc1a9dbbce86776c9bdee5eb7ad34b653d38d29181db863a324b1e3f4062820a67fc1f47a30165fb3ea5c35fa7cd326b1d77979e4f830bf715ce6820a49ce9c2977b3f16cd642e7f08b5a26850454065e8ef310e9977f366e47478f9117365ed278653f9c0fb316c8985827d994437ee4f17802518bcbf64b83f9c13e36fefefbef87068ceb6a50f70b650656e186589e335985cc1ff8e59f8dd2fcfaf2cf4698c76f1f600f8dc4fbb41b2f8f34f7f4d0006fadc60bcb3cfe7c7c7e645a3fb39c3fb3a97869b034fbf407fdf407db9cd1cd97e6f3cbe3930ecb96fc69c158f36183600b41e6f6baf1f2d4a2d9c787861892c60bc3308f4cebe9a131c15ee8375e9eb299b51b2fccd333d77c68289ed578a11f1a42f177fee79f11b2e8ecb76c416bf443635ae96a07fbd59e773031fda4f1f2fcd068a75e00c39fda66e385f9c9b12cf3f3270b9f4e20e7f1f9e74f8e69d13fff7e688c8fa1cf2cf3dce20e50faef87067fa335f627cd35699af9fba131fff3cf55b84a6cabf1f2bff403fd40ff235b400898ade3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3eeebb8fb3aeebe8ebbafe3ee7f2deebe383621eadf77ee0acd3e1b84ff77fe1865e3255c610c6dc620feef5b3dd4ce3ef9ad007fea87e3a59e1392d8be1eeb5fc195c1fe4deeb18cf57f6c3e7d27c8bf081cbf1ee5ff731fe5cf9451fecd264b3f7e2bca3febe32f07f9ff7c6e324fcfcda7c7df14e4cffe7c7ee4b8e75f08f207ba380df43fac7b5e782d9cff10c29fd3d0bd11fc397acf6df364fe8a78fefbe8dc38649dd4f86fd886d5adb2df910d7137fe10bbaa8fe6f2da0cc743de6b45baa0babcd7624d76b2d6056538f45a91a16de0efa7d56c0f796ffc21ee3a7d63d7a1758dd918428fd625f22176079111f47648533f45415f9b5e6763065c6806bdd4dc755c23901c53d8323a8b57e686fc350ad4475d2afece1fa17ddf10f2ef1859b9dc3204ecf15eabab30dccc12b89d2e91a1b8eb28fa7c428b42fe3d7bba7116a11a197dc9b1584c23bee3e8ecb36309eaa7c5b7d305db863669a83374b2faefd65cde5873c931d88563092e16056b2d0a93b515a8bb856661b12faf0d769388fdc946d726911ee08f85b64dec69a789047525f6d54f5ddb7f6f8ce6329e2a728ff7c8ca68aa34ef64f3c1206deb435ed63f41fa0b7eeb02feb47802f3e8c882bad1b596af6bad0f7bdaf1d17c80174d796d06189bbb36310335359a3af487e8d3ce87c132a9aeb560ecd810b826f45314f06ac16e195d501c34972268d70ad4952e70bbd1261bef2bb46106bd509f7676fabcb3364369b86039569f0f5c9d555656dfa579e77ffea7f1dbf97376e09ade8f5d806fb1e82ab4e4d22cc3fe3bb9f4f3efe0d2591fff152e0dff2b16b6e6d23597062e5ddd055546ddf9d435c5417d9936fbe3a7d18e0bd15c269626ae162c978e586b6d08bd95ce4ea2a3dfda363242e963c1722b3dc0a1351fb89680d786438666a8aede1ce2887d393234756dcda527f17592f25e3b679efdf19328e8aed19f60c833d93432790698d467568f6f3ba36927d4b5c7211fc077646c86e327c0ea5acb5d045b5cc5192c4e4d81db59fdf110f2449e760c0d7f9acd0e06c65fe6e92c17581ab3b1dad5ba7a7a9c9eecd05c8e161bf2fb995614db7f40c88f97fe51fc8f7f76e826fbba50a964648f4ff4bf939171bf8391657dac1959cdc87e0723bbb01f8e58daec84a56dcc4049819d191aa647c1646d4c3b81d1141d43e376fab4131981be3603a664611f626f82f56987587d79637e92f5a8d9d9e953e6c3609914cda58235ba6b834d3f47814b1bdac6d1036e270accda0c140709dc1a35c7437dee7e187dd5d767c411431d1b01c8c3054b0b5aaec17320cbd2c64e1c9a81ba319a399b1377b94c66b08fc0d252b13fc0a6f0fc240a2e6df53b9f6fdef35a0799b4af7af9784ec678dcdfb4ec0fb03968cbfa208ef521ad26fce326fb1edf2106cbc5e62729d865c60e23fd9538567fe02260f781995aac1b010b3785de872ee56c33eb9f80fd37af838d404a0d7691ea014ef42913a0b98b4de70bee0309ea07da31ad85c624c57843630338dac9e6e193fcea917432c6ee6a321bafc6058b2fe6219fe3e258409af4240a17dac9301dd70caccf37af638cf23159ff0e99768c7c1b1c3f7ea44174e35038869647c14ffaf1dfa97a78a27fc7599077f2c261c03cd5a7417d1a7ce33438de08953360433ec41edcb5b79fbc371ef2bef52ebe8208f83c94694e98a992f3be17ffa2fe8c9e0cf2722e5968ad70c1faa921e095d58efad3028be6d250ee72af535a9d665881fb40acbae371673a63e47769d7e6c47e676734e5f502eec7791fb001f7e24c9f90ddc38bfec8030573bcaae0b1ccb739916f79968613bd1d75a7ea602977f14ccdf37d349fd0055e91d58902df1e8532369bd290f7242277f174cec83d8591a237870c0c2df5d15c7446f3b633e0ddac3db5abce54de3fe0e9813a55b6dde18c0c798d897501ef449ed988fc49b94486651b799ffc27dec12962e5c8f4daa9c9b7fd6137eb5b77aa4e94a1548cbb3b4974adb7b2e66d480f47b823cee896fee6753e0c4175e19ab060b778a13d427b3b63da4ecd60e19c7c6bc8cf07a9c8333bdddb7fa798b3b3658a5ced836025063b700dbe1318422f3437e423d39f08bd8dc9773ca4b55c33f41dd0ab987d951685966b68ca50f2f19bf8facc65b876a6e770cd7edb31b45e64789d4fa3a9ee16ace264679c14f153a5b55498c16b5e6792ea3c972e34bc1a05d63aff5eaeaf1935b3357374adc5a2f9606d040c3642c9b1845e08f429f93d7d4eab6faad7a6dea71d5f9feba08bd9189abab2ca7e0456a9030b333dd97c42c31c58daa303f2cc917e2a50570bd6192a58cdc7c33f0e259a9bcc94de9bccb7a977693f17fbfa79dd3cbd980f424b833eca9f22efe673b8f387923ae82bbba27ebfe32ed8d4d559f533d319f5078ce1e5fd1ab1036c04136268bd1dda44d294369df74fda1979cfab91f7083a4169dae5deb419c9f56c82bcd405d5b334739f360315e8e813f44b165c077d7d30ebaa4bb9abf2aa622df3b5a2f77423617530eb4ad1682711281b4a913055b6bd3933e8cdba6a47a1d52f750a5a5b0f78979f2a8f11ac19e8bad440dd992c5e1b5eae632c652bd08be9c133d018f0124d5607b3a9c2956d7e22414d8c6c5f58efc3690774619f48e356627fe32c9a2aac973f872baeb6f81c4ad1eb8c6626398f69fbc3fe84e8da36c9f6218d9561a6575ba4a6d0db99ace24cbb6a47e6dbbb778ff3f5f9626d84f0adceb3d8975da46d9c913676c6b345c4fb83a9a4b45ea7190f9188c9ba701586fe013f8b3219d063368bf9e0539fb6d391d6f3166196b7d3b5c585eff843d557a70a8ddf67de857605199bec6487b40116793a05da36775fdbe1031ce8f3ae33e42592d3248c752bca4aaf3b94c8a0cc833935026ea54b11067581289cabb789780757e6b1b332d816e6335d250e78278af440dd8abce30fbbe5bcf8493e467fc82b95b9125a6b235086bab6059a3b5b2feb1f1e7466fe443cea5f2047ba438648d3b1396d9301efee69a3e8e311ad9cd69b756561d6edc1de7a7a9f764cdef1538def14bc64b05b68ad0ff44a57fbe2ccdb6430529d8237b54a799f1bf06e391f91d8db0cf929b31005c645dae3ca2ae9fa83f6873dbda7e0c960463f7ec1e92ca795b4fb3e954895d681cf977b50f6b71d99193b6f1fb433d2b6be1e6c5dbd39e6063b891cc6e48722cf840b564f169af529f203d71036dc48797446dac6e39d682a2bdb9ea44e7a735a1f4b8a35135f0927f24ca20b799df7a9995abb766aee606f6dbbc577a3c1860c4ff8c7d399736c00343adbf9056f91602f746565a0ca0c57f6d11969cf909ff30c5aee4958edcfe9c160e6f990bfe7f530fe4ccde575f273bbc7f9ba3660f40d198cbc677fd8fdcaa78653e8f7e47da6309d992f45d0c79c97face680a7c363bfffa337ad0d154ae37c38b08f69019706b0bf8496fa0ce3cd83bf979326f0e309ca526ab54c62b0f400ee01dbfe43f79fff2fa7b1e58ce9dc833ebacae8fc7b2ca55d6e0fc984b9906ceb185d6f2abb68d4caea9f0f4e22cf9d8db088066f2ef64e7743eaf391fa9ca307bde3d6d65e748c9f3a59cd776645a71dea72767fe943b7c67daa2ed3ec84663470f7a9151edc3c14ee158601f998b8edd771c5380331dced9e875c6e83dc5eff565951324058fe78cfa267741e67be6c4d7f18677a2a2ff153acdf70e0b67f1429b6091678205cc7bd0cb6c45e62bbd2df657d117066c60dc622eaf0d0d7f6465b94cf7743a1fbc83335d05dcb9119bd95a22c36bfb03de05192041990cf0b81e798fd919a33707d8e4dba9e5652ad4e86dd7f18d5dc64f06856d2640da16bf4fc593397c8636f182758126fccaf7e07cd989fcb3339afa700767f5b9e8bd156d96fbaa903bfc610fd6512ef94224f2c598c3cece60f5c810144ef424bfda17d1037b5a96de20d6e4461e0732573e265ef4077b3943f1c4fef65914d44734979cf12c357907fb19bf777068b0408f0c36030b7fa52b52f6dd79cfe4c92f7405673fc859ef666e8bdb8842d7598483b5d59c60d3ebd0c6aec3224d6d1edbf44037c3edac7674a0b392fedb07be6309dc7a4f8b5e3b457d39355ee90bfda8b4a572192dbd157255656fd306cb25655f80e62cadf551d9cf075a9eface9207bbaa727a2758177352d8ea7095ff70d99a82bc53ca9a428786be883cbdfd32bfd9dcabbb51283f5a7ce75914260cd0bededb000d0463afa423c61779cb780b271bd82b6f98791e4c4de8df07d84e479ab4caf01ac848f93a1ee6465dca7e6f30eb71eacc977b0af0436f0c6bc683ad13098c6b06c9e99dc3ab9439667fb0ce647795fb30fb4a359dea1a5d4d7b06dcf17699bd39db33f3a6bc833e67fb55c2877cb697183deed3127a3b8bef9cc9e7806f14e7ca603ca327a339d399ce68a0c1b3fc2cff7673bf6772fb6fc90b998e7aa3fe87d957f7fd18051c63b5cbef33bd99ca498acf65e9aa2c5cd284a1a9e97e1ea62d30e194bc7ba2aa724febdda83f57697dee9eff3e234f2565d2bbd17fda0c7a1fe5b7cf8f5b5d2a5ddc3df7fd45b3c354fabf41daa1ffb2c22c95dee0bd484b53453af7fd147c10f6fd0f3b91debeb47e57fa116cd70b36bdd00ea3aa5d75714f3b5fe6b3dace97f9bcdc0ee87217acb23205ecf34ec4cb2047f6b82f72a70577fb6cfecb7ba49bed8d4cde9ce77b2093098bf35cf17baf7285a686453ba7e3afd4e167a04b61ced4a9aeddacc21f8f69be94578fd6a9d2fef13a4f0b595963184b501fcfb53f53554556dd33759eb9bd4c989dc97221cf417fa32a6f3973761f7835c832502ef9ea9b44bbd5f311eedb9c54ccf3623e88471e71465a7e7f8631c11d3e931f7b9d91bcf387fcfe2c559740eb6fce977366938d313f676893ef24063b710dc15d9b4de969326b6fde5edbcef971b52fce39ef44fbb99eb30c36407f04734e3f47856f8b63820e8c5d3823cda52dd09b081cf8b810a339a1df3e94cdf8d5d956cea88a2ce85764ef631e05b2e717befcf94bdfdeddfef617fe7c3aee8c867e6dcec737e7fc0c1d1ead77b1879fbec801fde26ce299cc6e250ab0f77a0912b84feb9530e3d7f6e3843f7c27e3093d2ed37988fc95bd7c3cf7ae216c97665376cd503ad387fcecbbd687f1ed3ebc2a3df5cb3ee4a70b47d726c4d881ecdb3d96e18ee834e77f43e957d6a77b7b7d147552a58d8c0f497e20824d4b80fba3e48aaf8f176979ce740699dee13f44bbb7fb775ae75f99bf5fa2ef9bebfba54e7e6e47ffb13d302dee3a5e677f3f12a7edff77adce7e9dabbc5adb26f3e684369aeda76c5dae9c8745fdca3a4d24f56bfbd9dcdda0b54a1be7658e627c8779e90d3a12ad9e996f32402c06fd663aa5f178c6779c05dba345fed638b0c33b3846996e93194d95ed58143a1f8bb974637cf7d43b37e6bbea9d9f0bad3de4b53402bde6484907b3eec6590813d7e2db37e767d126a55d22b83d27fe39ec31efdbcf7b6e23d1bd4b7ca8e8bb440666007ab8c5ed719ec35e9025f7ba9f6ee127d1ce6c292ba5ebbecfb00fee5ca9c5771294f99082fd5c0ed0dc81bb99b3605dbc601367c172be3eed7c5a7d90c93b604ba191a07eeaa7fea99b4898a932d880a60ad8d4721bc587a1490ef8ed8afd4c4f329cd13d69aa7233a5abe6b697cca776bc36d8de064d0b1b4c8853a31dbd4b6a673067e48ee25b3d156c1fb97b59817d2edb52401f27c3b761ee955667a62899cd22c7b5c04761a8070cbd3ffb697da2f2be33d22467341f3ba3b999cde5a1ff7ee1c276382b25da07fd3b6378ed7434dfbbc8716396063de02762f166a4c991198e399d5557bc83d78b79271a69e037ad42bf333de58895b1e5712babb01754e703d62bb35f0a7a64bf967212f73a9dfa0ee82d33bd62c5edee5db370d61f4d8fec76b9d632063d882ea8ab45d3071df36a01b8dc1e75bcfe7d39d2fb3ed8b63eadf900f34e44234d6e55652443e3fc023704ddbcd954777a557e1498b5ce777ca369ad0c8173f5b21f3d9506ff13abef9fdeebc3ec7ba013033bac1375945e3b5b2fbb993803bef5b6d0182cf6198e77a223ec193be9098df8435ee35c53f0412fe5ebc5fc547462d99a804e5bd75aa0f3a1f51943dbf30e3677db4f7d3e003d53022e888bdd365fd339d8b05ab8d4b91ed39c147de9432fe71533bf378133f2503e9164650cba2ab071ee4c619ce92c2ce1f9746e0adfa2ca6f96592fd86458daac8bb6b239cbfbca8566d8f938947fdd337a73b001fb36d066f1dd2f36619b39a9dfa787bcb6f7dda70b9d868334904324a20778a51fd5837e4d40ff9f2ee6aeafcf3b34e2dbdb31e835fb78a34f3b81c8339111f4527ddaf6865e3956f148df99f78ffb10796620f2f24fe0d76a975b2a4ca72735b3fd063e00aed99497a05f799f77124bb32203f61e8cf3939c1b8b33e0e5ea387e6667b92667b6235e8a4ee71be6479115469ad31355c1992d7dfa3e957f9a7dcb5f4a64b0602567d04fe16c5766cc9e0f71a5ee37bbf71cf4be0102dd3bffb816f99ce64401ee3c7895d13b2ffe350aac35e8548d80037be366319789c88bab51b6fee9e76847bce12c7174e139931f782dcbcf79cb7cb2b6e6830fb81b0f847c9d461af05c19e69bceed418f6b43e33e5073bc1ef072762fcaf5c17b5dec47be9e726fa6769612a34e731f810197d36dc643aaf40a76665f9f4f3e7827f78150e889282b4c07f418238ffb3405f563d49c80ef58ba983e0f610ee7cce475da552792caf565e511f438ab110b7b9583f63ee1ec31b4eebaf09f9849b4db9b29bda5da65a459661b7d5c17740f78da987712de57274a4fd62b6dbf490ad3837e982cd8671917e6f70c4e925459acea5dca31cc69ab37f380ff9f6fbbea6751d6b9e89b51d69d56fc390eedc13c5cf40339e94f5e5f6124b587f7df9d339d8eda75a2b7835fc57ede645a7d837b4121a77b157e589d6fafb85fc0da2b9a7aa8af747bca14ee3e81bab204bc4273381771e60781b45604fae2d15c063f076cf1a7dfef566d66451b16363ec8f56fc179c5770e7c7a7aed1bc518730cf8ee248bb98cbfccbdca2933b5331e4e4feb774157b0a76558873caea66a173f696bea0f7fb72f218aa2eb1e8400d8bb90ff279e277e62fe0b9f276eb23f9916c3fe82f3e0a9e3e06dffb73279cda5f0e40bf5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4f5f3c4ffc79f27ce9e27a620c2fedff944318a220ac10ca728f16f04fbef61ffd9907f761ff2cffef784fcd34f8f8f6cb30ef9af43feeb90ff3ae4bf0ef9af43feeb90fffffa90ffffcfde9935278e737dfcbb3cb753ef8c170478aa9e0b708291719c068217dd7921b6b1bc74834dcca77f4b5ed8b274d2954c3d3d752ea60846968e96f3d7327d7e82907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f987907f08f98790ffdf3ce4ff2c24ff1f0bfeff2b7452066d6311d87feed8b6f94d1ac0b3d41d14801ff01d13a027f63f020368ef947f9b06201e69007c4703104581eb7d880650dbf8cb3080c150643000a1f74930006130ec49d2f01760000c19710d04388d88e6c7b76efa3f85fa37a3ebbd91fe4deaa32a375f9ba8e7e6ef8bf9e5f4e8ea8d7f9fbb3e77a2a3fffe672d6e7758a1095650e9cba358a3fa0fc71a8f3d911636bf083d611b3c5adccc53261b4798a464390eebdfc4fab7991c0d4bacd09da748952f8fb2fafb288e6c412a7cc5e8f9329e69d53098cdb3fa93a4466157e3c95a99ec3ce5891acae4e054e1c69f06c1e91d74e30a882356c869f2788fa7e3d04b263baf8a0362a9952be260bdcf4aad1aa95e6270bea5165841b93bca4aad1a4b72ba1b68cb317593796dfb7fbe54a1d8ffb759ff789f3e5da5edd409f5b937d589e7fe8f1f3c70fdbf11fa9be3fe9486223f947a88ffb04ef53e43a76a6b3fa25302c7735ca72c9224f03d5e1287cf75ea59d2ae9e2fead5ab4941af7e77bdba72934f54abe44ca902ba5b9b93bd278fa2b5b80d5419195eb2678a51dad6788f834cc5899ffb4ac8db11dab802577a4218ba891aae9752ea0aec13cf98b2b5aaf6402c5520cb30f4a6a36096f8a59d4c90164ff69ef294dbc284734ca9982dc7d44bd5d28bc683930d487513ca2dac90f3aafafd5c0ee892987eee890beac593c3da449b5a2d79bff4921df56266cb624b2cf53093e3996cb276312a2f1af5bf31959e8e8a1553e9749ecd1eb6b57262f956aa95526665c767ea69545e90a98ff3ec3a9fcebef3366ef35d58aea8fe2016ce7084b7f8e676afc963ce4b0dfa81f2ce9e3105cf2539c813df441b3c512911e8c1578c6a6ee9c84df4956de93f0c41aa1cc1a896caa420ca13f5d2bb6cb664f9d39c44ac6d7170bfe102d7a2751b9cbfabd1539e2fe5e39a93bd29ea9c97d082f07ceea60bea2524b40563f0686d67f23c66b34eb4b0c2d0b5c65b62d4e32aba8fae67b4fd4cb6b8fcbe6aecc10f5ce026748ba72c0fbab74d94db55c87e63fd2cc929376bc6b65110792c3dceb3ff7ec5acd5a147de375b3d4b7d5c4d0b5fb998469fb29816fe87d6d2bf0ed682b9e97f636e7ae60aa739e962ae998e665fe1b7eba7dd3a651b85edfb3cf785f49def0a2227bc67b139f89b437f73c29fc3fe000d7b7cffe37edcff0c3f6eccfdd86a73c05e69dc73d81f48fd614f145e596d5e266d2afaca6af395a4e0d1bfa747bfe022affbf4e5bab159039cef70e5e5b0c4537de328c6ce5e3e5f4f12c12834c128fc639af61d59a56eba48dd0ae56e2551379d9744a1a1ab189b63beb29a3ad622f34d5cd882b4d304bfd42c9512851e34b1f9646b55ab1a4544a09c3335222dd14b97ad512bfee099fb1d99aab99f189526f0c81327dbf7ae6ddfbbde969757368a7a669b28b2adb82482d47c5a21474c74606b60af92ced6e53bba9ecea3eb5dfb2fe4b9b14d3d63cf5e698f83a34c8a7a4d2eb46b73c1e8f98a5178022dddf4aeb48509eb9f9e2f4b916dea3f8889e2f3366627183f6f3789aea70beaa67aee0ae850f7fb719c7cb84e153117b9c7ec945fed8f076222ce4be35f6db743f33efdac7e38106b92121335f94571734a747bd1d601518cc4311125f27872de5e78ba28b1a2523c350ec49c07761a078e89725f6e7cb1dd6b5dbc3393e3a05ebb77fd1aefe8dae0dbfe4067697bece4e86ccfc187de64d7a5d38845fdb64ec5e2ac4eec1d79a9367d51e13e9647899718b163e9ac8ec5aaf99b953378a0777d2cb769f961f4b8dcb37d43e409c613d301bcc90235d5a967fad44d58bfa207624d7862a20d59d5cf2adb5a945e6cf4fce9ee7e75c802dca5a7c34893c7cb3a6fcee8f9b72874cdd5a9bc4a95b4513e58575ce09876e0261287e579b11276b937d199d654eb65bdbf50e5a5daf67b1cdd47a3c463e374aa2236fe34daa65d5dda503f339a3a5ded57587e8dff2d59fb90a32f6ab11eda4248597bae39f4701f31edacfdec11a7dc96ed0b1bbf3afa097b9f955fb7edc244f5beb8e90376ba489b3649169155e1e3fbf20a519fd3b78e6914fe4d1634e3242cbde99c8d1f8e586a629b3a5d8b5cceec3e9e342a94e96e70ca3f0e644ba5fed4a8dc687c60b6bbe66df19018e25c78ca599bcd1469efcbbdcc13f48c987ca8c5d2de5768e946717eb4c73ad54d5e2d2ac722d4db1ced8abdd40e4e5a8f6ebd840ac4c23956286b878a24930db17652b367ace71c158f6845ac05ef25bde054769b7754e7792a7f3996b4515ba729b76dfd9aed910b579cb3f38e0747a0fbf3fd319ef2127b1f2767e70df57827d77a5d9cbf77d1b7cd738e3db7cec623d3d226ff66bf2ba724f4a2fa1ca619cfd528c0f22898a58dde36e3b0f99be52f0794f9494acc5e9bee9d3ef44e7f58b5e39fe58de5fc5c138beeb7da8e6b7d5c35e3bb796ff4fd3487a09bda07566fd8707bdece8d1f5df4879cffb4dd9feb75adb585cff4b6b1a9fd2f7fe65372c0c6d578e2a68ba31fccd26e8d72f48df333aeeb73aab7ceb90667f94fd6d379f06d39eeb456f72d9d5b08066756cfedb29a33b46dbb3e623647b37933ffb2349d1fe21b2ee8f4fac1320efead4ffdc9f6fbc9375a3d9870193bd3d3e451c4de63630bb379c968e7f6d7f27959e3fd99c2ce0df7016ec76a93573b1ea3918495ae4d78de131753622ddc778fd7335b8f734614bf6fee37ba31fdba0df5ef666b43fd2ebdaa539707ce5f1debafb4d78b63ffac3ecdefab6836ff457f78addc33cd617dab9d9f977257369ce717c557f3183b7ffcef979cbdd5a88c3777ed6d54f23f89b11ffc1b31f63c1206e2affc9fa0eb7df9cfb797ddd7b776ec5725b4bb77c0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d60ec01630f187bc0d803c61e30f680b1078c3d6bec2f27d8b3423e0d5e2f48ef23f6f1c2df82f8e700f19cd013d1e0c3c4bee16710fb7841fa180c40e0797444eba141bf2f0eb9e12b78689eef891d1efa58cf97817daf250560dfef09ec7bd9a35e63f68d532f1d6fe420cf5d6b5c7a8c6b337a3fcfd99fd23d598e07eb6accb9dc53e90b463517263b62ee035546f7b6c953c6325a0946c5b87a78aa735eca384e3587f84d2e0e631f7d155fe49a9cfda6de5ca5edd4464003f411b919f2bc2472fd41efc372237d86dc34e6fe137a73ace8cff5e63c29e8cd6fac37573ef251b541b4a178aaa557759fd2c131fdc236f7911cc49127d2832d4cb62e4b17c43f2556320a2511d5bdcd4b3bc75cc4ae6288b6a5d21728a195a7dced88a997ac0c5f31d8b352339be7ae4963463df7854945967826d7cff5472f3136ae4828a3495eab655d6ec5535b900e6b4be788c9c8a6c35dfbfcc21e46cdb4054920967a6ef3de4ba41db11619b18cc3476df6a66ae99a06e72806ef55efa4615a1df18ae5a1effc5fa0825e90119b767d9192e90a52ea2aab8208d2d63651aa2552452aa99e895ae26ae90afe962c51ea0a4fa19b78852baa695befd2518cd09d2eb2e6bbfed87db744fb09078ccccf234631c3b23a5857acbe46e5326ad55262334e3d9e8820954478a25ae2972e23e457a8f412afed3ba92226d91253af67221c64b91c64a576bcb9a019c778aa532f25d43b92f89bef0df56cf4bd2370cdadf1de55e8c6b6168cacc9a88a959748554d2c6c6dd5625a38d68211c60ecdfb9f7953815eba09c94975ca672e480549685adf88c0fea1b7a873f82693b0d2a5458cc6f6e04fd5dc4dbc0c274d5bbb4a3dde0a4b084b4f6c68732df197e5bd71059438a6573062dd4a5c548e89d299acd6ed65094ff52d0d2e95f6aea872350d2ea05bc7badbe2c9b0b98d2031382d6ed34541e42bfa1e47fbf6795e3a26c2aea873abbaccb0b69bdd7dc3f29b2d597e8cd47793a4d9032d065940c4716557e343dd6f969626d903ddf4b484ba3765890f7483ad24eaef83bef6c71a657ddad79c09cad742af278f2f7dff26fd61a7e90f3b9ec99651d8968a9635214fdd106bccb9a27aa8db6fb2f39b31840c3799546ba31d237143abb40563ef2b52b5debf8fb6f882de148689422f6594475627556554402fd1a9375d945e147644c6bacd5dabe98b07715c8fe599d28eb78e5cba8cf3e7e4c9f8451dd26853ef6e3577597fbcad8995013df85395da267f69577a57f7afc9f3b55d1a65b74718a1fb6afbbc5c7736a68839d9bd624336ab29b2b42ec312c7952bf0d45742ea5b773f7bb7b53f535d71cc798dafb856ddfe7745f74ed78e27fbee6a5f72136353934de922774da3f4ad7966b73ea6c53ef56f198db4253e1efd412fdd043dfa0add1153e27d19e7e7f677ed7d5dc797e6352d6684647a353670e637dfaffba2d0a2de55596d7fac2efb61a6b47e7fe10be8c65126b97bdd8751f85adf9fcaebc89bf74e50ac1dfd47617e0b0ac7d8de9b38f9c31c14d1b5b666aad7b67ded5fb53d773565b6eb939a323b6dca90834ceddaddb7543a37c6eaaccb2bda076aba1b3072f2832289c4526fd80ec56594c89811820de9b1ab4bba6373451f332d9e724cff6baae62a315287cde9160ebcc4485ca5edf7a8d118c7d42956a4022bfe72757b1774b66b41a65ea647a75b6b98cf36fd9577fab5ed6f6898ed83baafab9b34c9e6c1e5bc7e936cf06392e147a66329d56e837acebb5f3ba13c663e5b39a67e38b663f1c7305278ea4fd5dc16cf745c64ebb087b2c469b2413771996f4f6b9c8bf25eb3b7691744e412d9dfb3a67fbe9525de2474f657b2c1b7ac0eec6698c4379f0ebe22ed07834aad754291f636bb29a8bd41c7367774a6b071dc0b5c91f91c4ddd64b2d5e4d1533dcf74df6354fa0a4dd6cbf02c8f7d705a23a287e5ca571fe87c8ba73af2043d74957db1e2e8746518aa213f4bbb5a18aace76b4b2e9975821bc9bdc6576351a6ae6737b5a62abfa4de637ecf3d8c7b15fd9a64e496a6c57e2a2f4e4a0beb56636d539571c6f18557df6fce6a0ae2d5b8d5965ac6f3ee566a06e2e178d8aac26f3e5323c6039effcfde8138ec9efdde95d307baf6f4471c0f4afee77fb7bd0d7040939fd35eaf5f7c7f1163563d6b6c63956ba1b985a52ff745cb9ac7dcec7545bf65ba707b3e9ddb6b943efe9d0516e91ad50e47e17d1f7fb11caffa0082d9f6a9f956f924c4b938dd30bcea9ebad7fdc388ab171b890dae613e7c8376981be25697e687da02c4bbcef6e2b6075ce6d6115202dea63e589dd1050104b3fb03502d34ca2d0fa3bbe77302da251b81c9f91ed7b0142df6fb1b2a3ebf9717d9f1173b2f595f0d1dfdc16274d5171fbfca8df5e75ec9fa25927b6364fba760ef2d917d258d9f906ddbe7d40d2a6f94789ac03ee5f49641df639f1576e4bb93e08f9f97ebefbfad611c95509406405222b105981c80a445620b202911588ac406405222b105981c80a445620b202911588ac406405222b105981c80a445620b202911588ac406405222b105981c80a445620b202911588ac406405222b105981c80a445620b202911588ac406405222b105981c80a445620b202911588ac406405222b105981c80a445620b202911588ac406405222b105981c80a44d696c8da06da7f3593b529e6af3a56eb7d88c4cba41d088017c4370189af0300de83451cf09f8145ac6dfc6500c060280e241e09bd4f0300206120f24043fc6d69882fface0987f80a8aefc636f58cd4c8af7c6f9bfa0fdbf42956f89228c6d6db6733863aa931200c9b2607f57779a932dc1ac7f026b8c6d38dde016e95367559a777f26b44dfd76144da7f62f44e4db94cdb898a38f84a4d113e4353c401480a48caa74bcaa53f9c348569816b4a31319f0ec836b7c8369ffaf67d86ec4891d371680bbb9008ab639aaf4205654e1ebdedd2758a7f161324fe1b3141421f0d7be8175cfada9d7f3e2abbaf6f39fa55098009024c1060820013049820c00401260830418009024cd0ef8f09fa7ff6beadbb511dd9ffbbfc5fcf3a335c4212f65ae7c128010436dd069b8bde2ce100465ca66d70f0a7ff2f09ec3897ee4ecfe93d67f62c3d6425c6ba964a55bf2a5255224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d90481324d204893441224d904813f49bd204f130fb3f3b49109be4eff9a66676dbfe73193ddeb53ea7029015f9e687593d64e9bfe5bb9574f787acfca1a87fbb9715e54e566ed55fceef71f33bf27b8ccbfd9574008a2c6bca391d8076777babde4bf7f7efd3018c4d6fd473d3cb463f4c0bf0dda622d3c75f33d3c7c777ea25d307b1ccdd46316b141839b18d3e898d16646d8b63a327f53273678d032b8da6406f52db3fc2ac7141e0dc6d079669281c3030f4a740af5174d363c53c6e02e882ac2c9042a58d1d16f3caeb71a0ef93c839a56cae41a78992f7733561d9823e915d08baeeb271539b1e51603ca4b12761457eb86429b23d89d4e12e05c61dc8e83876e9f5b8f6e9d65eba20b8ef21b8e9e659ab83ac95b6b141a17db883b6dfaf2c5d45b1f3904432c56056ce4b2dc7d13a7b8ae5158a1d05c53023aa3fa49127417bef82c83c1230fb0752f42289cb6e191b476cd15d12fb2d566edca7e59855c97d4ccd10ccfa8d15e6d8f61b77d95669a4ed20c84f10b477dbc190b0f4dca74a382c15f380a263e600ed0b5b07b4657dad84435a85831b181045e63eb5f2878d15ee3643be4bed2c4391d7e041bbd0600e8c63123b398e17d97806daeb7eb29193ca3c90a1cc50ec0c5885d9f6d8389b28c988650e49249fe6d4d788b5cebeaea40c5774cff7bba4038a7d9954375940bdaf2bea50a21a3dae3dba8abddab53c690b8e192c59e629c3c26ad82100f7d0caa5d49e756bd5cf533b3cad2a5df22b5dc6d5b20519d541469584d1b60ac7ec5356da2795a9cdcb9c26d1b3b4311d4a2c7d48678d43d4b44d2daf81a03defb10b475e60e7bd4b628f42e0ec3731a2b80a4f6480b7202b9dcbd80f5246062d27f6ac5b2a394d94c3e2a52da767b7aec2e7340a4f29c8da2bde69dca07441e4e5445967b0766812f9ed27e7085064969b48a3445d3489eadfcc19ede2901275d9b8c1cbd9b9a06434295184f2347a96be648d036287a67638e0c270d792fe65cde6b4f48e28a14714328e076605a32b7c447d1a69252c8ed9c6f62502b4556a99521a7b5fb0ea4f6752ba20965c10d10a027340e3ddee82893fb79c967c3f521a3b1d7c6c572b490b50ec1db1a29dd05a3ea191f7f61038fc6e6c228f4233ec9218e59be8394faa67fac11a2e6dc348cbd3ca6cb16516d85a9ff9e0fbfc55e9238d224d3af3d99bb1bf0643c9ef1caac32e19ded1ea0b96c6b3c4f592d30cb2bb60dd774b45ef522bbc49c1314b14bd4481d1e27ad9422b1c48a50f9cf75fce80edbb7bb39e1eb13dd4920b2a24e36af183fb61bc95497b68317a1aa7d4765a5c913d1cc7cb366caf814149edf4a430ee4031cb20187f2e67c3c7d4f85ea775b0f172621ea6f1d2948d8362a74a228f6e55f9d538573f058ebd1aee1afe9cfd3d9f356fdb8c9fab912e8cef2ff2d63ee85b55da5f8ffd14f0f3f8d33241f1f7e4db6f9f43866fda9e71a17aa76b9fc485d21f92f437499264ed4ebfbdfb655ca8fd0e5c382ef7d770a124496704a7eaf7b792fe41deb7b70dc73d7e07127ed44ee0c1bf301e7c73377e150d5e2459c1911e4714ce694473e89002e882c0d96385f0efb1ba38ffee89426b5c997bdeb6f634a27839b68efcfbd4a207c4d1233d6c47a4556cd53d434621a98ed9532c3174ca10a8c3501651bc0645720ec1c7689449ea4b1b3edfe10e826517c6ce9ea1acb992525ce86ccc96a3da48cf89450fe9307b8b5e4b1485471c99c7b9c2da9407ae3522deffb851488f235a22eb99a68a39f03dc41ec5b53f6c23ae71b2b7281729a14494b04b6d479bab53db40aef1848e3f42c64c7312d5cf1365df8f085057bc91d6f526f69b34825da2e887b9f29c27b527b19c9f7385cfdfa7bbe5fb35f2b5e9ec9cb21fcea5e8071ce9dd5c79eed1f0c17caad72491c610713f21e3e2030be0b4b1cc8ea33c65427bd3da889ae6484929293e3576cf50138ab4d326d23b32e8277ece35659ab54fd4f0945afa21666b9dc67427543ea2ea7546a6df2bd5a0b8349741608c968ded5152234ab209ad9be367c6f39cef403b59249a416c63bf89bc3cb5688f8ba30b6263406bda6d62dfe27d2d8e0a9a24f8aeb5d0ce19bab51dca5032a95ff743ac1f3f63cde4e80118f90fe6cf50a47104e65bfa0e5a4e8f95231f1f47fa6ea384e5f46c0f8ad71600a34b10696d6ad11c5bf4160586b4b1d619b19d7e5371abaa4011ea49b5ceb0a5e7c8a21d1ac63689a277a8a2751a3be33aa67bb989b41db643867e6b687b3d8e3e685bb0314c095a9c666d123db7db2a3c41db67396be9d6f629ae3d6e55bda5ad6b31d9503239c172d5569b88645f7652c6ce88a3f70ab568c85a709113ecbcc3015a66878031603594500c5d10ad79bf89ce5f504c1a77d9381b4b3fa56076fb3530cee377be124a6bd51fd89e5ce07099112bcf2d517d8aa9de60d59360c1503647d67cdcf39ee7654ad347daa5202bb87516eafb4dec3164fbc4ad1c002f6b3df7992ca86ca3849400999d8d82968d9356e600ad0345b161f067ecbc2299ce4b66a59912bb1320a32d2a66f9cbfc94235ef6f7cbdaf9184f8cee69b52edcc902486d7f3f070c8142be8751266bcb24764e68fd32870be069612f0a462f6e5530e45cccf2af81d16da223b72eafad47c673cc627ae2edafd73fd3a1ed4b78d6384f8141b1edd1c9f2fcc664ee725c5ff6f62c7c3b1ca67bd4c04acf89459f38ad4c7da45500cf16069dd3e95e179ac36474c8f614e9720a66fa2b5a2e9967e3be878f7e3ff26d286d467ebfdc01267fd9f98e3c639c262f013bf701859e94c48ee4736b24a773c074e86c0f6de64160164fd9cf07feac5b4fe368082cb476ab6808f45af28f065a3265283e511717bebdbb1b205b0ba9e88ec86f78e7cb7fdd178c67a384df6bf8d0e890df0f6e250dcca2fd328ce709bf63d9b37e23adc6fbf2b2969107cfeb001965b2f598300f4dc1efc721890ed4b5988cbec9b0bae8d6937e9f83d933e38db3be9f330f8145ab6d905f8d71cc884a4f8962ee31d056c13a755674b987f60517746b89daeb307442f0aeedda0f1d8fc97610a5fd596e26c3ec7e1ebd5fcf99bfbf0279c77ebfd06addad955c4e2dbf4f959b26b958861a60fa13a90eb7ae472bbd7ca13378d4a1a5b5987b9d4a07444c376a5612fb39b692e66c31413b6d507493919739989c6a2fba66d7644e8c082e98d784dda1929d0db38c75d7f624ac1a3ba637c77d72ebfd8015ef1b8a96e31d7bb97bec3c18af731e742d86c3b415611661ed572e80949c1a3a79677aa2323a7359d5baabfdc77b7ab18019ff5cd6756db5334fd1b5157c963969ecd0d535de883fd469cccbb827c3ec1f0ce7a596bec06a4a49e9e5380af70cabad2af3c0739887e73bfcb1aee33283e3b79279736a7e47620726915662461f996329c0bc4561e82c5c6bbd87163d10ebf93407b362ae1eee5ecd17bcc8e40b3ec9b837aabe968f93b7cd46b1bf4a99175509071794eed5b9bc9689b191e38aeedcf3599fbd3256d82591b3476bbf4f8691a718af5de62e9947e666e2117ef74ea942ba55640e84dd25eeb1b969be7f966fe65d521db23cf0cafa2cab7b62fb277e27cc437a99b7d0beb2e75b5572c1b274982e20d5bab9fafeb26f761e2e80fd84d92fde4ce6157abf0ffd98da8b6e5edcb44c4e70ef14fbc9289dd3f08482fcb0897c76766a123b9479cf12455750ecc40c1f5ef49925b7c8f2f7284e7352add9d9efb08a180f8d3840e5ba84c9c2dd46f6da6db5be850f8fd9d78763b658cdf610dcf7ec732041d66e819574819877f0a1c9a075c1c79d1f3ac14a867b7e2f995790cbf287963a75f3edf6efb485abba84b83a2476fd0fd8f73ddcb5f5e6811e6e715d35abaaba5bd1d6c1749fccfb1e96b7d03eef07665f574c3e8cb6007c90fe0b5a9e4c54bfc72f9ea0e6ccc757f4b9e05e7e974a8da6c3db67fa9163addabb5b57e13189b4363935596a3b328a2546f751df9988e9cc3289fd9e640d3be39028eb86f37624abf392dd2b1aa0d89451ec496165ee37919fa7963e840ce33f9ee75930bea8e760565df5bdace129285feed4c5e36a30fa322cd685b147df9ee7cad2396f8df39cb1dd1bde2827acfffacc2dac3a87245ede426b566e5587e2489750a8b7c496b279303b40735f6e55bfc551f8442ab45b9c16a72fa1de2591d63f8552060bff8ee9dd8da5974f011b231c36b19439f6e1482a5dc28ad7635b2a20f0efc8e4e9e6ed14474badf0142bba9cdae338a40a2b143b94d4ac3d646331ecf0c46a32e0dad76135bbe22d4ae765be0e4dc3e49ec032cf71c5781c721c34bd0181a1e92ffdd1f6bec8392e8b6b9493c2584dbcc078ba7ed3f7094f74e57c234ff6e77ad459679a73397696eb935d1598fe7afd38b60b189eb69e292972a61fce76c51e5a8b776f2826fdcbf04f8b148d63a934f628298c5d12693b143def994e9bc67041eca99bd8df6dc095977ff2ce924173c8c0e7e49ed3c917d12d15b34b94908dd3722f7a78cfe9c5b0c7e4f16518fcc8e40db2c22a89c37dfad0305e794bab6ecdf5e6b22155f8ec5ba1944474ef5a8b760e8c0ac7cb6ead1acc16905091154e7050988c636be76f0c4639b69b974c7edc336fffe3d9bbff03fa31babdf228bf99fb239a9e3dc85c86bf7888dfea7e87606ae4a995dd42109ee6e673b71edffa70194d4e0c832cf6b1c5717e8e4394633bbc7eeea751386c434f2215ed107fee4d9865c1c69236eccd093076733abe69e27a345e346e70cc92e1cdb8c3b92d93278ef6625795aff6bfb61dca6dd3c709dbfdd0062f390d704c39be1efb8537a93df67397fceddf64d7bfc7ea10b493cce467b687d60fdff4eddfe8eb022bfa8431e919d3dc7e0d66d5a7714d7d60ebbee5f3da0c139c655938a0f5b54c0c4fafb10babb1c2dea8848f585970fafd6eef3ba9d21f7bda59837f6d0586db4b0506f53fa702837aabdcdc49a20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca20283a8c0202a30880a0ca202c3bf7105061661ff67165f60cf3684d9adfb1f87fa9f1bfd6bc3fdef2ee1feca7f50b8bf26abd29d08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b707f11ee2fc2fd45b8bf08f717e1fe22dc5f84fb8b70ff7fef70ff4b38febf28ecffef1b46f1c3665ffe2d6bfe7660ee911f270278d7fc9c12e05ed2ce19016ed4db5f4905308597ff3817c0fd2517807cce05a0aa8a74f34bb900f81affe9540077632a00e5e677a50250ee644d56fe8954008c53dea60378e188f1cb1f05fdbf04fa8fdcf5d938ffb1f545268f1fc712e7e3dfafb4cbcba3373dfed32eeb0797e8727dffdf56dd1fa0452b68697d0a66e59c7adf36b16110957689ece744d9674fb1e412cbdc6d14b346819127aadf62453b81ac6d716cf4a45e66eeac7160f5dca301ba202b0ba450696387c5bcf27a1ce8a78d657649656a7325edd96f98b1f6699b5ab99c14da0e2b524f54a35aac167da2e805a91e0bc0dad4873b08965d183b7b68cbfa5c318f04401704cedd7660eb0d070c0cfd29d07362cffa24f6345c7952127bdf60d6b4206bab34d276d074645c9aa76da4ed42cb3c6de4b427d5819222dfe16899b995d727b593cf4bafc7919ce36ab987963920759145b27c4a6da7c51569a1bd774114766971cc50ec0cf0a1d1a1e51d10d02c6c993519b2765ea634353d8954b4434356a048536051ba2062740e0752ccf2af81d16da263b63d360e8818dd342b89fd1c5b4903ab94a6c0a0b84e3254992db6c2f24b31a3297deee034afbb6c9ca765e3904aef53f0f9b58ced610b32daa2c2a0a486195c4919aee89eef6d49f758219d5f9952123d572e706a142fb3b19f512511dda368790b41a8c4d64d31077c0c369ecece2ba9d8f95caf673cef79e90d283225b466dfe703567489f5c575c2e87c244033b7b64f71ed71de7241f9d935ee719115496c1ca145bb4dbcbcd0ecfdfa688922444961f0f6f3d2db63d5a32e787536b75f0323e7fcaf72feefd64a38a4d5ba19f756defde00c9749a4b589c2cf7020d6222363dfcb9a3ea45b2db9a04232ae16d926d2da1464139fb1b39cdd7e0d66d57427baa5a21f706476887dbfa42151d6b71038d7eb2de6b3c601a123e35d93adebb05b8e7762cdee44a8e8c3460987f9ec8a7f1ed93c9a812cff012bf22189b4d2b5d8b3d27d5ab677db41ca50a495ecfe81ec7ffee7fffd890a9fa1b97d4f3ea7eedf363e2bfb3b4dfaa1b297a5ff96ef56d2dd1fb2f48724fd4d9264edee56bb977f59edebbf43edf3d5fe8ada57644992ce8a5a5654f55ebdd1df6bfd772dc74d7ea8fbbfd35068febfb6e67f7b415ef4fee7f4f91bfdace439ae9c7c1be83556d86fae87992c2e3ed2f9c44a9e1783bec38a3324c1880bb6ea3e73801692eac87045cfe430c302ec37529d6332cc3e6c3357b83c2d40515e6425936d3eeb237b4a32083dfe17d7e30c6b300c37e9c09fadf11774b96dd4a4367673ea515223ea5a7c9ef6cbf039bab1bed01efb420be59be899a287267362447001f7e35ca50b62c97d5a362eaac32e198c1657547241c9315ea2c825a72340d37dd02eba967d0fc2f084564d36e206ef94568b777afc85d78f7c2e103b3d56fd071c2dbb65e497176c00f85d68412db97fa6ae9efc323cd3cfe714f6873dce5a5b91ee6e7fa8b6ff7736fabdf43b6cf47191c2481746fa6f36d23fbc1abfaaafbd1ed73edddacbd1763edbe381d3a178d96f6c5f22e063fbfc9dae0e9c1a2b7a8dad7587147d9f445a3daff4010d3a97a593fdde6325dda340abb1f29ce38a745875ea4957f71b2bccb1ed37e367efe9fc395693e7cff44f5487222bec528be6d85ef49b483ba1c89349b57e85031225a7897208d68f1e4491b94fadac19ed2eba4381f146e77b3dae6e2699cdf503dfcfbcf4db243a3670f73878c3cd71be9b758b55a32c1e6647aeab99ec1db47813fb4d683b39aebd23561d69f97a8dcdd51abb5515aa6fbf7783720f2dbebf2e643e9247678f15efdb2feac972132d335ce912b4d87e349aa83fd593dc0f72d647a38e5c675f765296287a870bed015bfa090519d78b103cead0d25a0c8c3b909517fb96e18f94639b490f0243c283b11bfb1a3bac681d8a3c6913e9dd2bac1394cc5ed5d99a461dbde07373cc27ebcd786ef953ba7be4740b265e592acf2d8a34c9e5fbbc69195f932a3c4d7be4632483f6b0b1c2dd66c8d81c6c0d128a73695e3a79a21c6a52e932ae968d1b1c5db02cab8fe67c996fe425149b328a3d692b73bddf26ccceb6cf7f873ac36d9b2819fd1c6f68852dbd9e5323c715dda1222b90124ad0ca69123d4b1b603428f2be41dba164f829c661fe8f01c58b6eadfa796a87a7f15cdedfa779398dffe8f1f1d755782296de11257c5a4b611096f48b3f7cbcbeabb5ed463a1a038abd239331fcfe58a694c60bbe57acc2ebb5be5a1ba3c95360506c7bf49a6fd7ecfed53ef7d39cd70c2def850eaad1e39af5f1e444f1285117051f6bd9ea17ffddd47e191acef9acdccb79ac33a2fac326d26a37607ba4e7b335d68f25e71158e5526acf6ee7c3fdf36247ba056077fcf1d65bcd8e0ba023ac86c3b2324f2834f2d4ca2eb2ebabea6bc45aebb0987d7b39ffb50b628792e098c50397214d6afbc779a9d154762889434ad46503cbd40c01dc5fe8fb682e83e0c8fd58eeb271418c72c2cfd73b42d08e63d0177a808cf2efae797d94e1da238a509e46cfd2f97ecc4bbf77194f053fb54fae7c547e8b80d1617539ca802adca5dfc1b520f229aac2613caf59b7acf4721bbcc88c175ef58e73cafcafbe4c24af47d69afbee16bbd9f1154f5866b7893c86f9a7b3ca2639becfc88577f9b98e7ccfd652e9c3b54ce1ed80b69af893f90b7568857bd6ef7abfa3cc7aee7e3e57b84b94f04486f77eb9492e5cf40bc7f3a07d87e5e7c0c849959ea07948dff0e409c58eb2893c7a7d9e6c9c7989f649949e5c5076be124aeb733fe05cfafc1376e74bdf9fd82c20f49444e67cbe87e6fda7d69556de536ad3230a38ad4ea942a544c9b2b58a76217fcef7bc4b628f42e070de262adac15dc36534973392b90cd667d9935fd6cbee7ea2982714cc0a2eab5566f3c05b26bb7eaceb198d189f9a32b697b7175957a23cb5cc3db66e1a48c36ea3687daadc4cbe59d6cf90b6b14161f0dbfcb1a31c55ce7b837bb616e6aff85218a3ae92cedf4db65cf4c6dea757b6fe659d700f81c3fce8e526d2b89c9c83d90732959f6531d2f1f5397ee70cd9b81c07f1332a8e19acceebbbe884535a2d32de7eb51fe590cdcec5e849615cf52dffcfedd266d3169ff621bf6d7cb646ef15f5177dc8f7778aa6ddfdb2592aff0eb394aff69ff621abfafdada47f6093be6d38edf1132ee44b43e142fe6bbb90dfde8fff6b17323d6c23e6669cfdd0958c15f3b809bee74ad6736295ef5cc92bd62714aee47f0f57b2acf1b32ac39bd40a3ba2d01ed7dc7cfe059a313e19ddb03f854067783bba86bba5f2dc1365ddbc32f3f8dacd23b138ff5c5e0b33d53eed91d12a27b6c15e59b4736a0c68cd5e49fb163361b83b37baea6f3b949975a4befe1ee5cc7c627fbbabfdf7cdcb09d230f30dab06c515e37783432814fce44cfef7f086ef6f820aec79904637b7e3bf58b0bdd1555a2df693093b604597961c4e9a257abcdca586411377d9fee9af94994cfd1c1678d5f20c043459fa33bdd2caef50ff7c8dc2292d9cd2bfd929fdea42fcaae6478794399b03a727d33f8531c713cc3e40056ffff18b69c67a748e9ca5077c9032e79d46f6999179964a4c7217f3d995d3e831bc61ced8d45a3793117ed170ccc8bdfae717736b85bb34f6a9fbdd7ff061fbb972269cb5c792f664d0ccad4525f7e1f16712562695df925748413ff197bb765abae057b41b37f255ac3adfa0c5c6fc9926be92c4943df75aa2fa14d7fe0b32a09e44fe3f7b57d7dd28cea4ffcbdeeed979019b245c1ac50861ecc460f3a19b3d16241823b0a76d70f0afdf53023b4e3afdb9ddb3f3ceea2a0e08a9542a954a887a9e3711ca316383a9d8fcc3e1f70439f64271cc3030ac058ff3890f1bc5a04e4ba85b3f881751b9c9e9699b511c68347c6912ac7256cdf7c486b1f4d4eee3c1613e39472addc747731a39a7553453a00eaf7c59d301acf6faa28fb2b6a4824de51ca28b4d1a39c52a129be179521a7fc20b67628bfb59822dd870ffb64d66b9caabef5b4dde94bc6c2b07bf733119fc92bde4e0efb496fc3421995c4bfe1e6bc99b69f0e11a7288435e5faf1f9f7ff40b3ec2b8ac3524bb7c64219ebd7c64d11f3c25ed1b7f0e1f65fcfa0853e0e9fed1ae4afe1dbee0b3d2677fa0ea37c68fbd67526e6e868a3af861df30fc15bea113f747bcc34f7eacd875f2430ff17139e91efe0dddc3fb59f1ea1e280eca380af6a93dbd218816e7f3aee4b8cd08f6f248f3da554479b2d9662ce2a7243c4e08328b981b6a521e6f084a45b8f3507a7982ad5332489ba4f44613aceac9c0da3f6ca6a7e97dbc73079dab5994d681faa6f1bc30362b6cb58916288f51a0d0cdfa106fe665d25d5bae42953f465e9b86cb926163f31804cb794be17c932795d9306c2970869894d62139a94a5205dc396e41b69686de2e518d35c51e9c6355491914ab687a33db4c070f7e725de61847b353aa19ad7861d1beb957c49b6d361d6d275fc8ddb88fc3d956f4650eba9aade1cc24d2c459386725848769d74e57ef46940f8c635206e52acae07e975b51e86b162ee11bcc0ae5a32cd62c380b785e61e324ce09c5b70dfcf4b0894fb353f1a6ccabcea1bde4cd3d8ac90db91ff5f2073585fc8db9d0d18946564543fd39d18297340cea580b9e6338975d883e67e20c364c39e306945569a86f68607ca251f191dc37547b81974dfb48e38573fca2ce161076265501cf747288fff933b567f5433ecabbf38ee98ddb1a2a9c1b3d549023301b255a50a7b6a3bba595c76db79d79588c72e8eff95ea4bd0d9161ecdf85c715aa0eb72e9c9d977321033ccfc40b4963df87c517bd13f836459c5f8ff28fca25d868617cc4d9f648e8f5402338f7999d22eda50bfbb9b1658399f2908fa01e519fa82b0ce0c5e026513fafefe1b53e71def3adbafaef2ce07b8c3a82a53b0c9a349adf907beb84b22eaf005eae89ef58d0bc5e6a875d32becad169cf36dc5d4b45de0ee441c078c377087016e6ed92d3b671dfda62f38008c87995177178bef814cb00db52fbef2dc13e74b712b91e429fd73944d1c0e1c9403c93c7e1ec13e441803d9ce7aedb1a6719b4e929d1dd53f6e53aecd9338dd6c755e4f048e36057f07dd167729eeb26f6c1f8cc2e4a8fa765b06703b3b3a56c9b91fbe3dd433efaf3ca674e521c9c52cc6b7a82f11fdd3dde6fb349793e1bf54e7dbdb01d16e7ff0ed2172b8d1faf734520f4faac2f9ad332ed20fc2ea97a9de777cd59e6ce97dd1d49f6d9b8c1b70c2ab33d98cf9b9516d4f4fe5b75bccb3d11e7f8419594d6f9fba27215ea0a0dd3368ec4b6f2e3bec25c14f2cd9ee3d02b4076c73ef8ded27396aa617a63cf17766677b2440331b7616b0d2fd98b27d5b1bc315f44aa692ed559b4509df1f3fc0bf66bcd604bdd3e9dfdafddff1f1845a20d6f08f26e974af010580e8d146fb1a8def99b50d756f05d6f29fcc2bbf2c14350707fa1e8336fa92f3fcbcdf9d2980abfbb3cfbb6b3bf3fcfd9e249999973656d45cafa71a97ac697fdb8773b57ac7b7f1c3c2fc7d662a9a68fcbc2fbcc46dfaec3dead37769e83c0993ecf77a7145b159c3f3fe4a312beeda01aafe9f1d586df8f67623b0db5834d8a79c33657b65ccd94384ce100e6976ff3d36d523c7d7aee22b0af45f5d705ff52eef13bfd9fc83d3ed4547d78f713b1fcfb38fedbe1e8f9dfaf45f8ef5a90dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4bee71c93d2eb9c725f7b8e41e97dce3927b5c728f4beef1ffe7dce31df7f875a6fdefe41ebf6ae75ff797dfff25f2e6bf8604f0aeec05dd4bd57e2b78eccdaf008fed84fc1b21fedd68c35b4522fefd5b22fe7d6502bd827b79dc795cf8664ffa956e56be71f55bdd030808195b0b825f76ac9a4f963c782076ba5d85b32d1051326cd5549beddcb0bb8f0aea2f14253b13953d6cc6f574313fc41580e0042dcab61b20c710a419da326321176432045320af80f6d4a7d1ee7ea19a94d8b3268d9c0d8da635c3fc94a25143236f03c4e329324e6c10b44012e3962fbb64d0cb86e19e2a50d1d3c82ce2c8e328db01466a3657d2c748091ee64b67ea2de70600779156b4a9a062f6b8b48accf5d532298dc363d79fcc1d04a72437f255386cce7d8ab5e2c030af853cda5d1397bc76b50f74914fb3585bef58199c005b71d591366554f4376d886d0210d89a6ac109712758e466c55a41e099b95192798a315f16461c2ed48eb405c095b4b401209627bba8636db8a798b780b9dae9d5e371155454037220af61dab1d749da100c18b5a343ea9b1510a7a521a963cd3880dc6ee8716653ee6ae7bf02e3b160763c4145f010e4a6bd388d5fa64bf5d1b382a9b7501b9609dd75cc01f39d1f2c8719d5eeb28e60eb5b4c0306a0fa833ef3382a26289f6600ba43b0d1b2abba80c04d90c520d08957b156dfb1d600309e0610cb5d00f31a9b7b1863187f510f005d1dbb3ae2c854dc10fa6dee9800a979e9b06f075e135741e986b386452620f8b704a9fad51808fb71a325d87143b517ee96fdf8b67a93944973ddcea5df4855dff79d02715f0b806e86c23447a5f9f9f7f440b5a076c3b4a1a5754a47df5597b03f57539b58db372c34362b2de5fd58284092967c874cf120e5abf0650f632fc0a9ec6923ecc7167a04022a8d46ce9a6acb77f6f3ce7606e99ae240877ae0191606ed379fd1ac61da821dcc9a34bfabdd7cf8f5f28ab5a7e161d1f92578461db2f0286c4680adf9ea86691e075df6e337e849de94d4360598d57b268b15b66a0134a601427e718835a3a00064970380d80b8fa3194f5aa36083b466d858536468537fa8ba9b71f3becde834ae67fef00500d858150c23cd3ad0fb18f4fe4890a12603d2306c6ce2f0d8fb89f76d8e4a2737372bac36148d3e3da1519346b3d605b03ccddabb9ab363b92150fec15e290e2a1a0e278803e0d361ec072403fdbb83591bb706e06e4f7e078afff562f2a9aefed8afbf1e85f5652ed19736fc9dc1d7ed2f09beb4a18cbd64ecf5cb63af7e2abcc65ca41df7bec059275a3641a5d70020281068a7c0e0824607f09bdd5aa916348a6f0498693403bf5292715fbea43b86970700b02468785d4f9b86c3cc0d3994d7089a6f13c0b4f7ef6a77b36da876d7240347c451c917daf9dd3ea4fb6c7bff87800cfaaa277953f2e24f94dbdfe94fee7e893f516ea53f91fee497fb933713e2d5ab501ce45dd403d4aa46bfdb704e6e69ed571157d9398ac441be0aad9a604705c8d824ef90db51e9f13837c54e03a2a6f3ce2c69f535c35c4fc3611fc1793c2ef99a81b70238dc881cce3bada4478147d9af4779efb4fc1d00efd705cffe42bb317ea7bf307e85bfd06e8cbf93bff8690848e92ffeeffdc5f51c7875112c348a347ce1c47e4bea80b25dc5dad18bbb190f51b66b01cd1b909109ca3a3a3d9c366ec91bb1e146a63645c397a93f1ca08cbfdbdc799c46e6218e469f6d94881d43fdadbb994f807139298323c3d686faa32dcaf8fb0d7ef6b818665fdeb85de450ddcd6882c2b71bdc65c905723c1d1f6a3618352c04045d5ed3c8d1c9bdf29f5f79417188c3fee5545564c0463e5d8cea59ab1cdd0d394e37e3e3c3bd408a5667f7a3a3bb98ab714873ba71385dc4fa6cb4ddfd0ed7b7df3d255ff779a2c45f8a7b6b2817dcdbe13f06f7f656b9bbbbb995b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897b2b716f25eeadc4bd95b8b712f756e2de4adc5b897bfb77c6bd1529f6bf13f0161af8d75375f836c2c9b9d05f9bf0af5e12feb57f50c2bf7aa328b732e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13fe65c2bf4cf89709ff32e15f26fccb847f99f02f13feffe609ff977cfcbf2af1ff5facce79faf4e9bfffac9f3eb57f7c1b0be083f26758004d3186fa19176038b8f91140803ec9fceb8800da0511403d23020c069a32fc2144805eca2f40020cbf8d0970db610268c35f840930186aaa3ebcfb094c0030a3f7b800af96d1ddfc5af6ff6bc67f6765df9bf0df95be38e7eedf8eedbcfbfd669979bdf4ee897fdcacfd68325d26f27f3c0df6cd24dbddb381a92725af56f63c9b6e462f0fbe7a4ccae044435d2163ba8eb520679af1898c791db7c36c1ebeec89edeca8b65692d66c6934e349453945c34980d7bba4354f6c10b4b1b6cc62cd28a86fc2b5fd2a9c7156cd3845a6ca4a8f27b9a9acf0329b47e63ad6d69cdc937a8a5ecb114c9b34d40b54f29ad8de96fae6d45fceac059f5904d31dc3cb6c150eb314af39b19d860de619c5bca5e14c61034727d828e1b95534cd12cd509372c689edb5345cd628df36cf913241d5e1d66db719d50ce52932f9436e725679156bf51d6b0dceaa79037a885ba3a2e1b00e6c7ea4fee84f77a01a28dbdd3eb5664143ba63e5322395a5d2c8d1496e1acf73b8372a127ca8c8fdd620d85927da3273907ecf34bd5c8549bdc4d666a55915f5a1fc76f234d867290e0e097e59a778999172cde310f44432077da90c3f24d86853f45a6615aa4736701482b2098a0eb7ae6fb671a857d41f15977b20139ad74b8de70cf39a2a7d3df634137a997321ffb9bc5b58fb55689d88ad1a04396fef71739de20c9ecb5106cf29190df502caa2bc103a26c8cbd390ef290eda4bdb51c0938a3fc4a1ca45d9ae1c8c1f5f459427f9e8e6d137afff2fe22ad8310c3ae9faeab67719e8c4c18eba0a5f0a1a1190238331177671759d96464bec6e8c56a15e11fb704b6c739d68b33543a3c22df4350b97e27918f355e4ed6834ad51e5e9095e7ed84e32f0da349c29c4de4f50386b584977f4b875e2813744f3c299f0aebdb966d4290e86e968ebb08103cf3b2838a40bf1dbc169a86f50c677acf278524dc53d3fd4151a391b1a990a1b90098a4017de2ed62c8546d30cca24b6c329e69b38f2b82bf437af17a575e86dca791aec0f04ddfd49c6c19a6a414bf04b1387de8e9549464bce19f64e6e7eb6e76eec92d2aa29d82a5e660f1b056cd6a238e549dbd509364590c7dda2ab73818d351dd31d0d5fc49813d4d93ef5f525cc29b7d7c16772e1a0a6e8bd9c5641315756a151c7e1f12223b1bd8660aba0c85458dbf98d575b186693dcb8aac7535864ee859dd966bb023de6a39c62be6638d8a4e88e27adf1928641fbe41be52a0cf6d49ee6c226075e939456f5564e5e12bc5e27aac7a15ed0f5a50db0dbace8dab5d70a8539812d05e65a8ad52343a39ad8afbabdcc8bac68dcd6ecc6bb54610cc59c4fc49c28b2092e6aea0fb3d476d66919b48966edc156539ceddc4cc805e3b248b1d1c6612ae68fb0e7377dd0d165ce75321ce3c8db124c55564eb7f1d9263b9fa4d068ad4cfcd1f67ddd6ef1c6e6f704076d521aed64be3350b6857e442b1cb4d437d714c60a8f33560603625fd9ab6f966c40decfc91a9542966c62bf9ffba6f1ec8ffefc60ceed88956e69e4f0896d9e081ad66e75dd8e2e6cef6a2eefcef6f8c677f8e66d3726a32229830d0db94623c778f67558fb8a55385ba73838917b258b23f308e3f6b18c6fcbbbc83c26edb076c1ef450e4feda065b9f9ae1dd18fc9d97f2d303faca279af1b5d65a103fd6f680efd9a354979b67f07d6b9aece32280876f4cbfad6d9cdcfe973fcb263215726b8fb4b30af53546493f77e5bd8d87e82a28fead637a99dd5e7be3cfaa39275bfbf5f0f1a2d132d50445fed9912478e42306d99a6fc64bf8c9256339ea275434b7aa2d13c035f30f9b87cf674ece795edf0580b7630ef605eb9e54c7942fae3b90ed1b75e5694f196469e9a94c3eca36751a54c507ed7102ba85791a552dfdc74eb7faa428cd5c735bd2f32af740de34d77cc16f65aa491888b3a1f51061b584392d66c587e7e76287438af8a8ce2a08ca3609fa2639686fa0e7c0ac1fc446cd045e73f932ae02e32d7ac9c67b166d5c2b6b0b74b342b673828881d9cba35b2b32f1606ca0a1b859bed4a58ab085a7f34961fdaccc4370356f297345c6e5fe5711aa61d7f606e8a3953c3baeaf2b32e470641e03f44dddf6f6762cd2559323079ac71f0ff2782d74ddaaf2b57fa3dadb0a1323ccfe27299c135f03b10e7d22aa8e376b4fd68cc3fee53716e77cbda51ede6fa6302317001f24f7f580f7d0c913dfae63ab14dcecaf987b2b8a5d182ff7791d9b8f9b09eccb74e52064a1a3935f9682e099f0ef2dffd791efb3e86dfc6228ee68755f8b24e4aae3cf9e66025d693a0807801c6352939c4093b969b1b8639c4cf75bf0e763106d887edac5999728821ceb1a0177a15c434e017cff105c41c91f63a472116496c67c7aa39c4267d2cae7bc2fe97177f3f413eccb72ee6009d24d83ac5b07e8a98e5522e8bb5f57a15eae739b84923a785b8ef1c23126cf4fb8e510ef5409b9718c627190599fde104853fe19bbe2ddff6d54f817e83e7cf6da370cef102e86682e8dbb8ab98ed576150a7e3739dea6dac19352d79f5f0f11ca9cff79ffda28fcf8b2dd80c02df1539ba5b891804ec4ef8810906df287c9a881fdc62d6404cf364cf7704adcba4340e81dfb5e572b12f5b536d59cfdff4e598d1c8692f7145df371442dc15b4fd3ee0437921fe4db0d5d2a093af93a7b8ed9f5b3ffa66bd0a8fc2b7433c7eb17dacef18eada053d22b0bbf9398e4b77d047e80facfb04cd33c2afe655fe560e52c0f82c73b059821c87e2a086fd182961ed5203928f0ecf7dfdbd6dff487cdac56cf91bfdf57bb4ae3ed73787d77b42c7f60e093e666edec7ebf3abd813a9e76b97758b564ec382e55ee864b4ed75f17abfb7b1095c077b7c8d6145ec875791734a91d997bfcca7b2bffe3e1e3befadf47e6deff7efbd7de523e1a397fd3825adb92668f7c0061e6683a0a6e3ce46889d6e6938cc58d9efff907ed947123b5d272dc49b41fdb3beda2b799b0ce65b586f08bed8747dd9c7417c8476effbf61a1b0b1be635d33c9eb4bd7d8bf1fec847e8d355a8ee5294bd4c7cdd64f8b88538e8d2d6d83876767dfc30c6e8e29363460aba4baa9942f262077b499a9b3ca94846164ac64abe177bd4ab71856b2e1265c4d876f3ed85b37cddcb5d648f0b259ba2ded7bc9b3b4ea137e9b87b47e3454ecb06ceed650f72fd6ec3d7a771e87066836f57aed7e9ae1d757f8cfca390f1122f8dbbbe84e8fceee8c0cfd75c64e6693407bf59afc2d9a9db9f410cd3db063636f1206893f67f33f65efc75dff6d9d80b1fc34a582bbf39defd9c5943ccfcf158a1b824c87212e555c713b0adbc10eda0086237bee9af7da857a14b586f413f685efbd83a2d349d7bddbedff1fabd37ccb7becf8ef703f3d85fce3bdfe1f771d3790fd2ed6dfd4b3d789c4d3eb31592a5da9a0b1b683fae3bfd1ff6beac3b6d656bf0bfe4b5ef1726e38487ef01142304d839409874d75d77694292351e2431f8d7f7da35a94a13d849baefd79d877362a4ad52a96ad79e87eef88dda0041e7fd89bd9c2ef15adb753aafb65d503a325d4ad86e01e392f381f653ef29313943ddfd4eb1b5edf24d19a766d573705eb4ed1ec1d031ea7818d199a2e79597add6cbb719c8e94f14d7967f1978ee490d2ee17bd214e3d493ff04e7fffbb5eaece76718e687f8407ee66375f21c2bdfda03e5db90f04b9fcc7b18fc0806ed653000bbedd808a727234ce87a2df6db9723b541ccbd8bb30f364981c7cca4853f106988ef09e7de86fb055c1cdbc88ea7cbfe515d113ef3e43fbde7fc7f4836237466b5be410308ad5bad174416cde5326dbb486ec92e05dc8c29ce00ae29d2d300f16369184cdda1b3dabde43c5072605f082e7a53bc0ecf6013b9ce1665fead6d1775bcfb2f3db8f4994d217cb6f7ab11d2fb74795de6ab20d7c848ee27bc75e45ae2d9876fce8cde06649a5091c19630c8f48967d37d473a6b00f67790d1f9f70eff061c5b77fd4ced6d92fd6e8af1cc1df53479932972e764046bdb941d4777d1184df38b79feffd3f2041917eb89e3abcabeff63fce547b79f58ab667a342bda72576791066dfb2715db9b0017909db66a0e738fd876be2931d8f075c97953774bf6ae1fbd219271e652358ee27b4317cf598901bfca385a2d1f000c3ed7fe9bd9f5dbfbae6debf2269bc978ee44467addef5e7ce5dbd363610c04b37d1b997309c9f0605f473e94e76f51851cf3311ac56c250d639670f28e710fb96d149d31518e41672251e469c79446e7fdb61fef7bcf36aceb7efb40f7d9d6bb2fa093be7dd0e642dedb88677f97709de1d6fa26fde2f0b849dec4df77c5348be003b7ce034f65368751ac829ee3398ed11e64ea1afb1440ce607a3d5a3b7fbaac5fcfa2fc7282755026c5f3344263e8ae40bfea6597f14bac07664797c7d782fc92ea3db0cb2e23d83bbddbf7ebdec5d32469554dff04fc65b4af7f6aa2411fe371741dff07c845ee4fc945e81cacd60b77b6aab30b54c8293db001bc44826c84f159a057d2d6f7c04700df03b40a9edd77c76fea6a78fe6eff02196abbf4d560dcd1270bf23e347fb07b823cf003f8ac09f6380ff3318bcad23ef8d4536a2bc87ec86357dd5e064ceea3ba821dc16ff16c8972175dbf77d0ab8fc9ea889eacfda7669a457505ff69ee63bf2cd5bd602f6ef34301ef6b642e627f2aea5815322ca267c0ab735f26b25fa0180979f3a06e5f3ae01722b488d87460dc052f13398a7c898ddec2d6bb7bc45b0cf9d231827122d2918fc91b3097665dd6d177ab9bb60c4427fe5a8d3e243fc01c1458c35f6dd3e3745e6d7bc63c0be3f4d4a036636a9706f9679c567f6bd5da6dda91682f079ae04c61dd2096c4982c4f46f8a26f3bed121fddad9a68d3d936764a1ddda23100716e0b81790b67126c44bf5b7e18e9f2a559ff19a766594e7d97dd0bbe03e14fa35c59d06f0e8ba82427bec7d601bce467cea6202f3df94f1fd733e1d966febbed74aae85de16c7a5333185f15d9f78ceb48dfed2af452a0f95ba001c381f2313e3a5d4e9edd19b319349fe1757793401cd68cc84242acd3aa2fa3988b27ff69b6eaff00ff23b56f4e03f8869b7c5ab45d56d065e35ab62bc0be8b7238acffd9de87e073069aecbf82ef4a7547a063fa44063bedbb2f1d237cce24dbffc8399a2e272f37cf5195ac44642ab0cbdc94c5e1db089d84f5a891c5efb1152cdf6a795a77d0d143e16c20d9794fae03ef42b67cd904db74c66cfa1fa33fdf40be35a5e6f301fe7cccf361afb06d14e809c8641fb3a1bf808db2fd7b79d5f0cc7057b4db227faa18f7357ddb76fdcc1cb79bf86c42d63a567753bc6ee3a482e674cc99e4bd83efe0b510cf0cbef6bb6df00b845337ec6f284e057884ff8abe59bac96f789cfab0ce4af09dc5132c770ee20ffcb980f80ab426226f413e74757bf175f0cb4e9ef3f855d9bcee77a3e883bc7a6cc9fedbadb3b20f06275d2ada917e8a2790f72a316fe3d9dde6fbd8963bb9c1afd7fe136fb3a4670b9d71a4638daafc23053b92eae89317bf684312e48c82ed470dc689d105fb879a9a521fdb21837da4603a75d6ba069cb947457e89d4edcb1164096df7d256b7fd57f547644f77864bce37ac0bc4073be6f6d2fe6ef33480c5e0e07971f129189f967b14b3235f62adbba638f6bb653ebc9f63bbd1ef89f1089d3b1fc1df3e773c7e7ef8dca9780dd83a2dba176407e6ce9da3c8cb8e215f68cc21c4d0211e55217f71f1a367880b7bd52710c7d70f1519eca423c7e8bd9cf65d1fe2003d65f2d25677a3b7b93b9a9ad2e8156c3dfb608de661ee504c3bc455412ce079bf8358aa9793beed70f159796c17cc13f43f780ee985db36c4ad076ab041f127602fdf6fd536cc13d3869704d995103e741c555e7f301e0e8f336b8aed039e573de6a09a97917325f0b161507e7f07c9a979fcc9705ac387710cf5f50cf800f8f2a80cbd3a5888cbfba6cb834c5dd9f11c6217b638de09c697b6e3b381f47247dfadf1f525f8107be9a04e5f257155d9dc7d20e3f980f757e32db2c5f1f0f57d7773863859abd7bec557f331c538ee4745e6c6dd35c5b276be1c5635f20b1f03bbe3df25c6ffab2b90d941cf879815b04b609bdcad98eeba78211a17b5dc8e4375377dd6bb630feb5cc8669b91331acd56e799108742de0be7674fce31c872ea6e9aa2585e88b166f2238d392fc467ff886ad6a2027655c0931d8a637aacd9331473037007669bbe1d174f62b5f23305f41062bab0de56ce7720b17d443e0219f36c0420d30cdd4dd76f6b90d301cf911c036e5db1bd0ecf1dd379747e97be2e5f0afa6c873be310538af777be853c0016738c7c2df0dc7eb7b019fd0afafebeb7c4b4ed8c7528044ff23fd415a707bb231fd662ee8ed6406ff5de88c5f0ef77d3d0dc7620af86daae3b4617624c613ee4bcf750fc386f8be7e8734ec77f2ae6aeb05e3379703627cf36c445e23db0c9731b6ecd40be6aa0970b9fac333a57d1344cbff07bbee85e62a0e9cc3eb24b5f49fe4f1dee91fbe66046631b57d84e89f257c65f1305e62dc1fbe8b91e9d8c09c488c37cbc29acfd8c9c1d4ec7fbc8f985383ffc7dbccdb89a16501f339fd701bfeda96c5ef5dee69cc7fca1b99ff7bb9757c8f1c8ed296712dfb90c1449f18d367dce73399ff5c408061de309e23321a619f2d4008fbfda6a6f7a3277431b68a229fbaf104fba0f3d1b789e22834e827dc82cefa5fb72d2279b5407dd1ee93be0af061e3b084cf0bbd0b1d1591c396ad7bcaa5b13c560237ce92d63bddb071f2cf243611a39fa5beffa992239477507638d43b537f555a0cb2bcf56dcb38df2c3560f333ed610fca240b388fcfb46f3a1e6aba1a762fd33869c390be52961ba6c5c876704e77e3d613be1e6618ff24fd68ff49ab4531dc31d7595716af2b94af3eb574af303882b34c2cdabc9e7f090b342f018f6d7de4f0af0e3a5a3424ed016c5ec4da535d19db15e666be1cb497f8d5ca22f257ab713a338c5c9b2af04f899c3223acdcff09fcfe285c59858deb6989f8739f856cfde7489f67c24592ba7d2ef01634de53d925f8dded201fb05d002fafc626b8646b00921a7673611e47b7806ec1ad16ce521fc9016be68ab0af731ba8ef609c560c33a7d48dfe5be03e2d113551abd9970160203f410badf10374eafb31834953cfb9e3c0d86677c7e0bc1119081ad2ec685c32acfc1a2f1e36a6f8ae923a241c3a0fa8c235a87e1be55f3e98330563fa0f39986b7e72dd97e680498becf03ec2f83679bfc69c23321d09e07a06ff9351f5de3ed31ec1eb289d824a793d836df4dcf3a2f3ece3f23f412ceec985e1b39ba7c39993d92b73821d765ff44e35d907e07f22dc4e4018e4c9e4ffbee2031b71db05373f95505fbdb3022b966a0a38e3da06fdcf8990afa88cce5dac92cdf1678766c4ebc0cec817316ef0f3e519e0ed17cd7b63d95f7c8e7a26dfb9ebab3c1272293b9421ce9809df7eb686cc963c875f5bfdb8c06a0b83ad3051b36a131107b0a678cf02fd5edff3027d3cebeb794c939413157e45e6e3b954745f8f57e0be7ba63a333bb6b03fd81ffa67bc2a351fe64753e681e0bbf7a40cf481bb27ed2c7e8ce1adee1121b8ba47667685d62513efe888ec7f0c9a13882e41b7aae59cc07ddffa777e540b1bdbc9d7722c073348ce6c6bd946948e59c3a24a65894bd6af4111b688ad17d375d78c3b2e6225303c0e7b36d748b746218dcf59d13fa5dd8afc1db2fe8989c5f8f930db8b815f9c9ae5f8fb64d756813e52291338965fe10e8a5eef2b28a7fd2c3e70fda0b78d9c509204e057089e799489fbe32f9f80d6c9bfbdeb2821735ca8eb9bf48f284f167b29a519c01bbcf47786bb58dbecea64cecd730e7ad0af18787b944f2745d41e6aefc0ea2c312591cc5e0d36745fb89606fecb4e7247e43756d9c6b34191d916cf52342397c7a00b13fe344dbc5b0e7b1e083768711e80650c300c9ad32ad0940e608350800df7b53b0a9c7fa76dd9aad9498c582209bcff26aba675bef26947e83fd299b8d97d73de049f8e21b2ef8e50647aa67307f2de8b9e8f9feda08c69047fba636fabfebfc7bbcdd16fbf1a96fef40fcbe799ef8e08c701b622420cf78377240b6873a12fb6efaa64c46f02e0fd60cfc90c51c779a23c3746e796f2bc132de23fc5d9ca6bbe713d1434f0c7fb6c4de5b958bb3433220ec77b4dfbdaccb79e0c407bbe3f9e8e601f64b95869e224d57f91a29226e4bd39c6eb9f97e4af69df93f3b513e2dfdaeb5f9613b48c91f0ff1c2ee4d9b2f7a96d8ece959a8b0f956ecf92d7db598f70ebe3e981389fb6039fbd222fb116ed2b53c80dcd93e5d33c0db4a7ee08f4ebaecbf5aab86b38aececd3b7b9d7f7cdeb5d67b5523e6073929c40ef4d43bdb76c43fc31ada9a188bac8c90851fc5faafef81a2b5c2c92780689dd4bf4b52ff4ee25def7bc6cb9531d9de41d521da68a8ece7e2435f1508c86737ef7a24d2f5f2b96cf83e4aa8a9cbabcae802bf0f75a7c8777b1ba0a8f779cddba9a0eb635a9a8e5203dd887529c3cf094b60d3562d6bdcd99d055a873003a18b355d1fc68e38a62e2435d1eb8a00be4e772837c0720cb1ba4f68d7e1d85eab6ef1b01f0578e56413d1df9255603ff55913bbe293b27902d241768d526d076b66de23a3b5cbd094253a4693e4e0ff0a17056dc077b09762439b71588cfbc5c7489e51ea2efa474767e8d5c4647797d80ac9f7145fc31ddef96afda985fdf69ed78f9be72f655429b810f615a7679e3ea6cd4ec5d356d2bdaa358bd1fac33bb42cebd94d70452a46588ec4c63ee6c203ec7fda630a27c867de793f44bcd7dae360dd699e75bb065e530c0cb2b6288b12e26437ef5c3ccc4713d0dfa3fa73fd93e95cb4e405f89ac5b69d3845819a60772f6801a9915f6f3ad51f67447c8de4ae45064a72b7d3bd5530b3e4bf25dab6a59b5c2deb0f2ecb2ec8872ed47f99c36a8d60ed383aaec165cde7df51cfa48162ee94064ac758167a901b211c233c82fc3d3c886e7989f13eb8ae85d90cf4dd73bdfd3dcc78863d7003ea47684a96ff4a0160492614f85bdeaeeb7978e5a8c2bad9e0fc5972a9c8e9531d2931afcc667db84b8aad02bc8d877c51f94cf22c8b3d46ec36277e85e7aae104f98cb28cde384548edc103b239a434d2e5dbe875c9e0bbb866a4af9d5f1a255e76e2e35e0e3468cfda9c149a2779e6d7e9cc21edeb99ec4e779853df3443cf4853854a4e37e3816e8c378d66f8a0b4a94c99ee82fd360bf7df1ad5e9bc4ba94e3b96ad652b0f39a6e5d3c57390797fa3c182f037f8db46ce7b9fe6003f7bb855a17677db2b982bc003c8fab07d15626e73c163caf1b45f4bafc3a9fc3a7a3580be433839c1a36f674827822cdd9c5eb2b755c6bf560af760bbcaf1083bf22b648e4a3c3797158cef191cc43fc2ce93ef4a86f04d92731df5894e224abd7b889fe7a442e1d25e66eeae8c8c6775f9cac2955e4ca61dd2351b70f4d789751be375b0dff97f2eda921e61472379eb31fc1a63723be502aa37e7705bc1278f23c14f79aca027a30682b20b3cbbe6f06507f674d738bf01e417dbeab9867f44c6dc012a7c773be1ef49d13221371ba17cb8ba85983925df2b62e20c4dd17e3494db71c875bdadb8d40534ab8fd1efa52f00b57d2b926bfc9ace2fd37f25148bcf5b2cd6c5d0bbf5bca43a959efd25ab07c3a4eb72bad7d81ee105d90e9d1933df2c55219c660f98b4a99be907a20f9bee4796e45ba52ca0dfe1f474fc46f6bb4034ace81f0caf6ad7c37d3157180ee5d6e23f9180edc9dc3bf7b013dec5593308d33698d089a2ffb2312ed2c13557f1eb7f37a2f14ee1b86a373fe507d8f4521de91e5bf8d027db7c8c838c1cfd24dfa2de0fb9f6e514cfa1bbcd3b8d29cc941a6ae463d146fbb73ce600354b70b779eaf1147372b72e1f0debfb1fc3ab7864e71670bc95777c7671672e43e220b55cfb13176933fb788bfedf6c99d3251d5f7b2dc1d4eb62ad8748b319d85357747ddaa7c039827a1053ed2f783853d853c3276ae95eab34ee3b0381b42ee83e5ec1cd7cddbbc8b9fafb3e736d6f32ccff73473a94eb6e06297a8dd59f41df3fb8c6832f7bbc6efc3db15a8ee279c1314bb82f5bb5cb790910f83c20b755fb11fb8d6e7595f03b7c62f25d4c25d55f862906f0e7f3faa676bfb1ec44d43ecad298da0c634f8e298df72dbe9d05807a683f0fa3daa073b61b614e0ef191f6f03f167b8d629bc63003e72563b969b475ded4cba66d57ca67a0d7eb1cfac691e3536083aaf926e07b610f0f1f7591c47ce0f1ade93d731403a118e55bd004e14e214f2daca209ba9d2086adab11c214a9f516d51f96b214e0162f4377e2966bde2ac7034b172dd664427bda51feeb7fdfe6c456479f71df9add5736279aed48f599b3f54f37cb58fb24eef64b4b32a8708ad3b8a1f7d1263948d4e554e509efb4acf84404f0bb687baf9a37125129f95d7624c1a718bc532d7e16c951de2276c62ee7f262e966c1554af413480d8468bfa44dd39c7f519195faed3218a3e37ea17a91f37fd52f2a5acc06e87e74765dec63182656c201e4478c4c26f5adb4a5fdfdccbc798495e11f7df3d9ea0ef4ef628f76de67e9dfd1a5e20ccf567e93dc72b61dd81d7aeeffdf6df67c3abd9eb469ab2f2e83c6ed98b499c03ea41f105d985ce9576ddba35fbffc18e47bffd64b877e8dd643f40af213a12b123956c271fc3f989b80e5447a8d34766a2adaa94d70e7b0db1d1cd73ea53993156be3dd8cfe4d94a1b5530b81ad7fe77756794f3f926f5e7f303b63b3a0ec8af346eb3adc199faf6446d1b2e179fdf684bf808be4fc3fd4d1b8258ababac9bf1f98975382be40797f7ffe33af107694bc99e724327166dc7a49e827bbe77cd4bebf12bf2f0492c0be309b98e5f88359e2cecf9ae404f441d9dcfd777146c034ba8fd827c33aad3cccbf224cf11620c70fd2cc88994073db0b754e237cab1c0f1c0bf761fff2fdbec6ae65e410f108edd8c9dc96de4624dbcc9bebabe147716855a780c6e5855174aa86d784f4d28b10e5e6e03bc159bd8c00b2aec9a2cc6d557a521c43142efa88e8e729748fd45c0235cbfd9b72623927fa624a40e5777b62adbbbcbbe0511672ae89368335bfd440d906a79eb965e50714e3bcdb50e38bca6787cbf4e50f5cdbfcecf508e97227141627c63be066ecd997f8fedae47be55524a323df67b34c544a239e6f18fa22defbf3ffdcef68686ef5a617a475f431e903634ecf4da83f6ef6c68d8fb150d0dc92c6b1a1af6ba7f1a1afe6968f8d18686fca9f8d3c9f0673a192e7b53c7906dda390d69bb200568dbbeaf48539a8d79d2b6fd9866ac91aa1b285b8af3365058b0a439a6348af42e64d190ac32044b2503549904755a549e4877129ce9d801af09583769760b8d0a05ee84ba91ed46a8dbe08fad1f92885998f71479ebc62faf9abc49f7b8f233a94a45e7893c20af46b08188c44c85ca60640c52ed0328bf6704833389b478833dd4b74f500d76baee42d47f07ba50a4da16aaf42cfdb94fdec7a2cd19f7201d4458879b8c76b8f9019d01a8b560914bebba4bb3053b83aa3545d1a032b1e452cd9d640d637c41dc3de33d37cceb02993f36a99e213f70d1a4448b971fca19eab5df2444f4d2f99da83769de153af7b98588dc7b23856f8d7b9ab9c41b46aa49300b4d43d4705396c454ba2f4b825ad020ea273f032f57758bbb31ee57a34cdd191cde71950df22c72849b6ad7ef2269975449c8ad6aa3efeacefc46d620824c7e83768b9a2c63bdfb0059bff43e392f6a88708c55524813bd6b3c42a747f5da5fe0ec960b58f822a07dc65b644f03e7a477d33754b584e016be4efeeea568dff75d359c0724d3267c26f321197c5b7a7e41231d06744dac2e99c75b64c3f3781c926119f81954f415bc4f321967089e2666757fe5be3306af117a8e45e6de4117b6cf193bbbdfdaeccc43d50a7567fe20673cda5ffb9e11204f464cc7cea5d9028ec90fb4f2c7775ce112bdcb56a4a12d6d9fb38a7336509efabe8922f19759b923531bde4f2b4294df1f16a5eb5a3a4d2a24bcb7eb2cc0f35904435793370e95d2c998b1be1b9d8c7061cf86d1540900afc07bebe1ec97bab15703462f15d2b1f2c63ad28a0459aea96e46baec83363838b02e5c40b3308f2868b4277a9fcd63bd8cb1b6a394ce33cc1f7052ed7ecdb4ed57d4e577de655924a4932dcdf61b7a50d56cca32eb48159d3c6a205b91cc1de3caba3d91f9b26c3c360f1ce980e16684f7f2b44909089e4803944dc4ed45f5f978023c7f39aee48da34f9651a32649aca0d4e3c05707d25d5a1d88568421f453f464becec312cfc91638f34e51b7e38479a0eaac219ca54be8c055dca3dcfa01d6fa9c06f746101d7982aa49a4ea9763ca63172a73e30c96fed3c21dbd19f2e655db82556a61a36855e097f2f24aac7719e2bbe0adc619f29836cbe3f67e95cb7d3a3acb677b8fce24994f5845efc76d13f04ea6956dfcf69c7400c0b4bb0f5610a80ee640b610c8428a0cdea7914b3229c02282b2f8b90c5f643da655c0304e305ef1177cff8c7e0fde8b84acc50f3cff0d912d5edac00790ecc3784e91afd87f531a5eaca899574317de45f80faac4c7553ee1bd84fd27aab51bd7a10b9d2400a7506418daebfbb2b918ffe80eceeaf6a190d545cf1ba6d575995dd84a5a5b8d8f78088a15ff503793333abfb0af74ad5fa1cadf13449311de3145eb3c133b3fac2d49cceac65d71974053def6db97bc4212fd3e523dc9984c4f6600735de451a7a48a8371a5d59350466042e4c536d056a33b7e2559372007bfeadd4eaaed20b37061eb3dc536824182ba7bca5367df4df28817f9d99e51de2479f666d26c319f49f1da92cea26515680478c9e4c111f39ee74c9d4c91c574eeda7f9b13e789f2d9efd7d149bbd6770d69cab0040f07f2bab00eb14fa84bb21ebe38c81add7dc0b21654b128df73690569e4f1cd695aa6eeccb525114f3037f7fbbce864ee37aca55081c608b1c57c5fdcb3d752e548c8787c550303c91c9c9c005933819acb2d105dd9b6869c4c25a96b8b589351053dc40fb0d5988c01d52b035265ef1e1987741114f595ef2e9257e975c28f96ecfe0cbdc34b880e22e814505986cf109daf86a7993b9ad24a5f063bdff48c29363b8b93677b3371285d7d5324075b77c72fcb1f9247745a529184c8f94df4c59c382736564534e05d5dc0dda1ad48a3461a549591083ac512551c33444f8d0cf83ff2d4edb4a35e3b54cecb9427bf3ded3df3552743e84a0ce76c1f0c8016277ad70ca9875799bc40f42bc9261d9cd5dd34af0a41ab443159beff84df97575882f33703bd58de3cf07aecb3e471722ead8a42a29724563149acb80befaaa139640d50d753ca87f2ac9d67783f5aa34204d4eb3bcf496e45f696fe3edc84339e4e49a3d739926f7f977e8368385422847d7a45ffb26cd05c86e1aaef81ce47640fe0252aa9a287700365fda18c40d24993a32fb5b41d2a321add7554887e63f218a70f650c36e7691bd027f6dba547ed2cfa6ed3deef106db5357970d20037e5aff61e3a3e885584189f037d17f07e8574605471933cb3f4a01333e8a6b83ae013d7a5f9993d3f4715582fc97777945ff350d7a26cb37bf167d015def5ed799dbc3cde40850aac0f63394881bf49a78bfbe861a5bed787f589c83ad06ccf924e076743e1a3f324d2395fac162d56f9087f765db9f7c9832b89867544fa40f1e40c5dc113fd5b64ef99dc8975b5b9c7cfdb46dfb86732e930da5ff3f33b5b8db8ecc745a4152a9ba3eefdfc78328dfcf8a93d687c278d7e15e9705ee513c90e12914b27cf68eff5edb8bdef3a7c550f97e15d857d8ad3df8a19efb9fdaaa07be57270feeedb722dd59344bda9c86330be753a269afb08f4f944dd76007f28ce21def2039fe59321445ead6b690981f71bf4cd4491db148ee819ce16671d17e4c73c8b25c5f2633b9b4d50f5c1f83dba2ae9bec4ec9bf9bb39b9c51633fbf6d77e49b6990774bda84cd82e9fe577eb13c588a34da675fb27b3fb20d0519cdd3ff4379242df3bc82b6934db96f177d33dc2f854259b71d90454b660514dfba2fd19e348698d9409b5bb8b1509e8d9a8a844c07833f539a0ea2357364f31ba66472ac4cb9b0bae30f36c2bc133b27571cf92883cdc457bba7ab81a5785a7095fc1166a76b137bc604f039ec6645871de642fc377ac07dfd19a461453bbac68e32dbd0fce16d5fbf3ea04741f59550286b3a44a229b57a1bbfbeba19a670bdd6cea7837b637503efcd2862a39c4963142360b192a9b7cb5f7988f06736914827d79b61ddaea6edcd96f2f847fb335122bed7878ec99bc8fd4ab13cd242f9ead4afcbabc46ab11cf5f5166c50a78ed9574feb8f6094f2f9f67a1d2a5fc9c91312af8c799ae05e26d8c5fa36f257c1a74f93c3ab3915fe3b514791cf81591ad88f0ebc50acb3364cd04fea5ba671bec369ae4db8abcb998db71427837c92a7c28d85dfb85e79d7026dbd16ce5c5b3ed50a4e5251c82a8656eae9253fa26b4e6024fa5faff734678ed5b857d50c854d95ff3aae895eb5fc9b3193e7fc3bebc62e5ff3cfa08fbccb06f06eb414f76f93d4c7eba634d889f6765b3aaab4053f13ca88d8d44e66c31df003f18a2cf326fffcfefe5f3705eb9aaf760dba4df17579c77f021e0b9b02c0bf1dcf363113c7e04fd099d93de335462e779cda322e773e26806fd36c9dc5e8e055a58cc2ee37cab781f7439c9bb65c90fb5556c7899b14cef3c7bd19bfa205f2cc24da25d1df65eec137b20cf3c64142e7f0fcbaee2d60be6958a7bc5f835a9eec88d85ded9bebdc69caff591f7730bf4575e96f05577471d7a9d8de142574fa562dd6eafd56637f2600db84c836cee6fceaacce30acefae4f61fcbb7c1a6c7dec9eee5b4137464fafd7369f4973126e3aebcca35a47361f3e7ab9e8b63319fe4fe8a7530e36ac77369949a3b581bffa4bf461cbef673fd89ce618da2d8c939e943c56da8dc4de657ca8414fcaf24c3b17f17df417bb5f90bba19e4b287e84bafc43fb60783acb80f79a5ba6556dbf576e1e76b91db2a290d7a61f7bc4a9f62029512d97e74fbd009076cf5acd380c93a0df0f327d18dc1e69554c0cf758c09c49e80ece4fd3a3c427be855d822a1bb2ac8b8b03ea86305f5db66f9fa08f320fcc4f7e6acbadc94ab148c2264856f23bc2a562556293887bf232ea011ffbbfddf7706b8aa7af0cea68e87fc7c60ddeea543a21ceb0b91ddb7e8107957de41587e103b12de79467e013fe5cf6e42329e91bea04853d03923c5e56445693a5d4a28c604e61ecf848c88ff837b5cd3856f296f1215ecca224ff6201aff57f112fa0e8197784b5f9737bf9e973c91716b78099dcb7fc2396273e9f68beb5fe433643fc0e75ac7736e9f25fe7d24030ae9c05c84392f63f3b2e9137e3ff0ab827c2ada3d78b8e9527244dae8f94fb305df219ae200f029ffe967d7409121b6d16fa3887bbe9379ad1c2ebe7f26fb5e1eff267448bd772df239139f255df399e4659baee31b94df57e8594bc98955095712c9cf5d3fc737b71fab12e239b18a2aad509ea464391e3e647a6f08fa517e16800ed6d01d1e27b833c1be0370b514e18ee425929d2fee19d55df375c0f84bd78eadd71d3212a99a27e848c58c70443328ffa1bcb80c57ec16367aadec0c26a9e4396a6f5c7e41fc8566a6802c0abfb9f71f56e879aa7f225e5cecb83993389f25a50d34a610e149c781eef01b2e6667c6d9a054d9777076c257dfb8b26aaa344b91c4d47efdbbe06b8ca95c55b475d6db866fc436ca0f0cae60139e96e5983ceb95dac075b7afec772f706e58c75e3247b09b4dd49d284be467315fef8f9f75d3371b2a6f4155765a5d5977c5ceb950116e1696e652ca2e6578283f731d1efa3b4d866e544e8ded0ad31de80a833ad6b34c9a3c0b69d9ddb485ac23f42d1fca32aa3b6b1375d7d81d3551eea38d457a78da07111f17f13af7d83ea0317fb2da0f8c5f913514013e7dd3205eabedf8fbed05c5ca1a2866eb2bd8791c5502db821a1be14bbb09cfe6d2e8a475c7c05702e529af6035e7637188cf83ac3b54c783caa4b6de5b46c87e44325335d299ef2e5b4545fc5b6dfc8c8087fe81cfe4da5f092d848e86de25d6b77e7bf6ed299e43f7faa60e9ad23098ba4367b57bc9330025bc0f45be5f55b194c56200af78a236938ea387fe5f3a64a2056aa26d5fdae81c97d6bf436d56b601d5c6bbebdbeb456d36db7166ad0724a6132a952f1ad60e3a144cff465dce57651ca663c27a5a93e515e2f8f22e4a7ea8435cce0a571a2af8bfdb349e89ca014cae0c48cc97db5f5b4deb5fbfc66f2bd20d07f6f77988e389d0777492f36e559617c4ea042f53d2f96ba07c7b426747dab2ce6579f69f209f0c69072bdc1da893d2393cee503c53ff4ddd4ddbacfa546f9c805fd7e86eaea65443f7fca5b30f2efe6c0534f8d2d1b7fdb7999c775023be6e64bf825859f83eb496d45f250f5c2d7c263addc0d5dc3ef39d819c4ebad82726c433c073e3d4142ba374be1c80f66cbe26d8674d7cb3dde9dff380e0eb065d8f188d9ae46bf7d70abaa5c11ef1efe17c02a4f20b549b41310757c45388af32cf7eccbbf0916e69fc37e279b12e55f7be8f563adc20b8754e3721a68e64bea279ec9e631c4b8baebdb27d20fc8ca3b3c52aa5d49efb02958f7e74610fa1e396ff4abb8414f7fbbb3b62fb8cb26f0313687206b949d81f6ef3d50b62c8733240a672fb5b32d7887e37f5b91f1611acfd15f406f08118db73b626d578a083b4817ca6e3abda7ba65d48289ee6fc99c4e3695b33c31551618dd1b3f65fecbc9b6f731f2a2a5dc07f1d21fc9686ee6addffb1dc4cd7ca93ff7df3f4d52d5c9bfcf006abe56a34fde12fff824e7dcf2b5a6106628a8750551dfe3d2a92622bee10757e86f8429e1ec0bc51a7b29de3efaf846fa2e7ced0593384fd9e86f0fd35d548286ff50757bd7b49f0195a3eccfde949972f2eecd18c5686a230c5f8ea3a3c80675ce28f76ed5891125bf12f19f916361e8d619c495e150fafaa3a853bf1a278d30589178a85f879da795677f9aea944b661ba1386515758969bfb5867617a0e85df4d43621724fa30547040f2946facc87d691aabc88ed3e19e69db4a80e284dc922f38e4ba83b22a2ffdb6224d093f56ec1957298f763f9dad4857b3eb08c3edc48a7a429754d6a59f564fa35d777939063a9ca2eacd55f2f46d5f55b1cb29feed188171d36ec0d98e068762ae4d836d8456a9c27b62135d754d6c7de5d8032186ee5adff19456e633c0c6077a34aae6f71c55e903b3d5304295ad37c4efc46585cfbd42c5b3f15731f66421ea7a7997926556eacc238fafea75e82e51275af8bdb6150fe240fa6d05743f3c07928fe3b1b8d95c87ab8f4325df7b42dfb1aaca6cf7a6cdf14034ce9de98548bfcbafb1f38a2a7e6c5875e6d219675d5f89de081df1892e89df0fb9a6a4938807b67a83e45acda5510f77f85d131ce73a53af3a28470364796332baeaddf1ab263dd01c54fefdaff4ec831d06cd7d6346ea6e8aed6094df4acb589544ba4273be40e7a167bcc27683f644db2eaaba2b137b0fecf34dd8783ee16cb42b32d75de10ca24a65a2be5d69a379a23117641d6817875c7626f10db7f591dcdf3bd2776b1837117d4ed01117d3968ebe26efa5bf37b4131239df5b729fc8c6343e82ae6d4e9f853d64fe7e0cffa27f0f90dc42bec17b44959d489cc96cc3aa0572f95f883e11dff58bfedd6f17622a2f6f15ef7d9d93fcc68a38848a7d8848cca468036ad883624cc4fb622a50eca51d43475ef19d4a854fb0dfd10b9de6f3d8475a1d91e8e9ac5a6239f645b475b06ef3c406c6f685fc7e8e8bbf77ab73858e518c35c1dd2deedfa3a6f9d267296f6ecf6eaeb12cc4ebd5d98c52cef651e53bbd2b3e71ee0dcee01be1f29ffe32a8cefc2312f4c0596ee3a432f80d3e08ba2ac65f64f7a4b1529297f0b11adfdd517a5879d9ba3bee926f867381ab02713e5f312e71184d4b3eb7db310ee4bcdf8a2301fefd03ba05b20a58a4b278c107f728e0a364ff669f5a3b9efb2f8e19ac5955cc7ccf88bd7d85fc849c9fa07e8eccfefe2be7e76d1e406fa5f313ab31953a50dcaaa4ded18334d37b26adeccbd33f7ecf6cbd377c54a4cd05741d7dd5ffcb90ec98e5b009bec702af22b526162b3e77187c3ce33697d378829c1403f2d87057ca4c910757e46340716e634f9546427c34d5a9497c9b50c7614eea5cd4d5a9a8ad435094f3edfffebd157e4c57f32d23fd77f2b7ff6ffd7a47a59faa0768c59f6efbcbe3ef2cf8f3f02b0afee049d6d4fbf9faa7dccf9f723f1f2df7537534fe94fdf98d657ff2f43894463f20cd2a076f606edb2336b47649d3183079fc85ee7b482d47a65420c724ac083f8bd2ce47d00810917330c5098526593920cc1a704369dc347026c560d663cda1215584b88aa8e913bb8cbac0528888834311f2a68390ca1210f573f78c4c19449cc7a66b77e45b10ca3d79cef782853c11f3b64bd5adf27b67136c16cf53ec3d1bc33ad4f520965c204d84f31238239d3659dce1f08788fe9e11570588994597706da98652090d10eddad94646c5691feb542c303d6bdb2531f93e54a7ec22d109dc5c0997e64dbea793b6f7db69a26e484361695c91e25dfa3ece05239a270a220e27d290f526620ba44cea726e323f08a997cc5c84dc20c21e11171f33b94aa874c3ab7675c81e3f30d310375ea93c004ddf61ef8079915053b41eaba16fac9424c7a5f29cb9ef66c57669fa722eb6c06f08a9516368b6c69b8ee04ccdbbf8fcccbbe89be919250db397014d155a775f4e86bccef03923a51328de5d877fa3b1fcfc7c525739d9776496a7df4a4a7ef886ebbc51f71036056031926ba01e88ae2026e67da92a2f42f621a82da6baf0dfe6ded8a3054e67920a2a5bac12d588e0cd1734971f91ad92a2aedfcb78056b8a4c52b0360b2aba8b670344e49e42c698edda312b5702b871ed131a38edcf90991fabb32aa42fad9c202f58ead3a6b925317adfdbbc99f22085e2bc6ab7ef002e40616e3009f0cdb1105d2129c1798a7b134ee00637f31e3fae424c843ea1bb5cc39235c20342bfec2a5ca034ad946278838fe00646d2c031aeecfd8846cf2610fe0130fd1f792173cfa634a27406f11a8f8cab13e8aef306e90c704688daf2ce3343d6a7e6cc301aebbfbceabd913f277c6ebee31bc94378904342860bf0be70ef9eb346d797b87e6953f9a18742729e88cb91d27ada909e96ff027ee6e16bea4e898c6b7f8d68100e7f8214a072e37e19c3cf88eaf5cbce1c52c7163894e435aa2c8db10f400d071e464d0d4517e4d037af0a0947f107b0c7022e488a9b9f45bfb0f7259c115c446af0602b5ec7df433919081dd8a921cc8999ab88095767698804d7570e19a7bc27c6b5ff8dad237e869acddd993be555e8b88a1efc16b534d262b759ff441054e1c4ca1bae30dbed3c7c79f8fad0e97f798fde89758a66b5b3cfd4ce2e553b3b8f5f07bd77a99d54cffca0daf980d5cef6975fa4767e697f7de83e7e44ed2caa9cb73527fab349192dbc8128a6fffcf4f9d3bfeed74cfff9498be3cf76f4e91f9f8c283cb836fedbb1343f75f0dfbe7bb08cabe15be46764dbd611ff1d58e9d13512fc23b18ca395e2bfd36b6c99f8cf93e6bba696a2a7ffc529c1fffca45f532bc12f4ead4bfae91f9facd0884c37b45bba96588f0ffc955798fb3f3e59c7637484870e41fa49382f87248c52f770657f88b71d2d715c233ac62d3bfaaf20f353170d2502056e6a3896ef3bad408b93f498196976b44498f8180556ea5859428ad5fedb8e7c2db4b91bef7e00fde9a469fce91fb5c8d18af4c43a9e34ddf5ddb4f071497ce8f45a2737b68e7023fa1cc556985abe051b74fdec46ad28b5fc567ad40cf4355164a3ed84697c8e8e76cb3ec646cb884cb41f35b7314ea0bfff8dfffef7a9530f9ea45a9ac1702e60811bb50ef0c38fec56e247f6a77f7c0a2dd8c1d04a5be4cb2300889256e2daa1062632646fc0ffb40eae6f91df47eb000638f4976d5d60d192e8088325e9d188c213fecb0d6d1830b98606f9a7a5a551e0e25f89a1f9f00e44f3fe45ad31fffca467073461869c018c6f44417cb492a47500cacb5fb0df5c01e0cd777582d39a1b5ac796ef26a980e4c6f11aa711fba3a55949fec37063b05eb1df267fd34cb4fc8765988ef04bb86976fbfdce80bbe0fb6e9cba467ee5e0c649e7a19d5f703cf3c0fd0a340ed8893d2bffe586a9750c35bfa5474737b46b6fb474dd6db89b54de34a23049b530451b53be6d85e9318aafad53e773fb73bb02a0f45dc53be28257dd6dd946d004e1bb5ad308ba6b0791d900603896e135dc378fbadd705bdcf9aadb89d674bf881b151067ed6826ef016b1d5ccb6ffa6611bbcab705742bdd0efce66f0a7ccf6adab2d04d52abe90518a07570b5b401ead83889c4d1bafdc766805ef3ed7ea7db0490e9a96f3500a47ed23800dc6f9881a1194ec3f0a615272da08bd1d1b48e37e08c38bb016147a6a5670d888ea06ac8000101ae5e7f370afd6bc55d17accce5cb472dac4260b89ca56ed513c935111f0acc3ef743c4d9028a8a0f1e8d87fc4101cb1247eb08bf04141331aa8840457c497d8e6ca57e525a3001e0d26f73a71f7eb562cfbd7cc2e67990d05ac9df7ecb3cba27eb58bc4a4706112ed02d9313e578a94e4bc20eff1b46ed759b2541dd0db5e395bf622427fea7635daa2447feb730bfca1be8b183afd949712c11248ad31b1067f76895205e13260f88374ec2d7c756c0ffbc043e2f035b97f8a4c1d2c33cab6462ed18442108bc47cd742fe23ddd8a8ee197566c1dbda4f577a685a9eb17445dc30a3dcd4fdd96ae195e7438b44e0f458004fcacadcb050e62ebd4156f5bbe96a4ae011348ae0952506fdccf57ee68d96e921eaf379f888fd1c935ad63d232b5e3d90ddff180ef86d9e51df089a31d2df31d0f9cddd08ccec9cd27405d4adea5ced8d17ff9917d6cc1ffeaefb40e5968d4dc4f52b37407e4fe5696b9856f0491ffbf2c234aae496a919fb6965a67edda3a75f33d0339fe98f9d6bb9e3e6621a1f1f73f03f4d84d5d2ba9d3f1ace3f17cd4e2badb0d2a600ee4187ec32df49f96a4b74062ed98584def4003c547b488b7c012430bc33bc0981ad40896469e1536010191bae30310d81d534370152f7d8dacc4d1ceadba2df17c2d4be228495b54cbba75bf7548ac9b304e7638b46f4231e436e2ac4cc19a1e48422d3e5a879b0fbc25a97917503e34a6b7e2438166bb46146aee1108506c1dcbe7e31ef346900186bdb5ec48cbd228b46cf1766cf9be050707e8481a058523127b768bb1a77b6c20ec93386072cb36cd08990688f4f52b876b3996665ac73b47cd6fbcfb0136a35f65132a3f09b65cbf6547f5705100628075894b0242190a8d560b141f23e350bf0af876be0bef002d6ff23bad5fdac13a46b5375a811554dd344a241c5f7f3d6b7e6a1dcf96963ad631d00a240b03c544eeaab7c47137323d4adc346ad9516a61da1c7d26a2c067236a697150750dae63c36ce55db67c00e64719d5546e4032d9b319ce8992f4ae01f953da08882d7fcd3001b6f23503e5f6bd66b81389626a84c30b7cfb03dcc870b41b63c59e8d09e0ede1e00bf4ec70b08ecd7067d7370ded685642b1f35aba93a49ae1e526dfe2edf4a88509d8e24b770f5a9212a5a9ce94dc6465d6d2f4e8ea596a3501e99a6d6b7623486e8eae01b02e307d10cca3d48fd1ffb8cf7ddf33f96aa321d0e21ca263f0a1b1d85f20b7fefc086c6ebf70a896159e78baf26b0605a85f3fead1227a60dd8014f21e98fbd09341df81a70cd6f623bd7912f0cb6dc408f8e51a2d6433312db30914a43ccdd65246deaac112d3bb71bbe586e0eb0aac30bd67b47c71181fbb03f6720bf2682551766c3ebd30e2cd239e5801683fad53e773f7f173bb09f4e65808e0aeddc0906114c53550f1314a01d68f5b46e483032b3a92a74e9d7b9e4142dc3da07425ef1a579841ae9de5cebc4b8bbae890306e67c8de5509d0adbdd17262cdf0aa6ebb66a8555ea78803c681c43a1265a60896ef207723b926ad2c742fc5eb20f4b412cbc88e564b774d97da2a4a3022f92fdecd421778131aa21120a423941ca45688b700dfd262376969b18bd64a8fccebdd0f81a3d53a1e69ccc77b1e63fed98a4728dbaaba9113d0fa87a9c1b009c0d742c33ade864016e03bc0e0bdbe8e3eeb1ef0d835bc837b4cd23b608f51169ac74877c30660649506f736bce7dfec67a38f1cc4c9d0f2df3eec9337a230b48cd43d11a5a806ea68995698ba9a9fdc05040c019d927a686a92be0d413de4b7c0d0816880bbc4d6d1453c0a0c5c5a9ad4c3c2c054d368026044e697053ddc1e9042dc3e221c24c1431b88dd21f393331872def1a41f69e63de01469ef81cd159766c0db389e83de83a735126c33ec4d8c102049c8c83da04ccb6b06754ddfba032cb0520d9c6b7780c6472b4daf77008234e09face33b405b6698bc139c5d7dcf73b19624a9738c32db79cf638cb537c383bdc835acbb71e4065111e0b2e49e015990d12d485117bf1316c4a47374f4c07954ff9c6759b1e6bba70610621aabbb790b2563ab09b9e8aedd8668c6ba3b77f3c626dedabb54ab66558839e9d9a1c0ae4ccb7783f73cc0cc2a77c23333dd9df0c4d7dc00cff0c8b41283d883ef8426aeeefb064f4980c33dd096e982de695a072df3ef7e07fb72d33a9c34ffbd4fddde8af2338195249a6d2556fade2753cd7ef723d6e5eed73097cb1dc01049099bff0ef05a1253014e4226ee81b5add0bd174748d0ce3da0343ce91ed8f8a8d9817627f0fdfb9ea4776f076fa1ae85ae178e4510cdd4e21b7324d1b3183e0fa5bdff893c2ea3e111ecd4c717dc03d5d1ef84bfb9d5808f49cb0ba373d8d2c26bacdf0f6e664764e47acf3328b631d012ef3d0f8149cd48dff304ac40926a41fc9e8720cc21b68e097926f6eccf2e0420b99f4f1dfeca550bfccf27b0d010d731fcd3d24cdf3af6e8d5967134f21f0764dd73a2b395a69fc1d61293506627456e5ff88725a3408837c5684d77859f8916f2bf7537c128975fb9a696e6dbc54bf41cb38b86a3198ef6957096fc7274b28e9a6db58ea9119d843b71c6ffa431e4be2b4ef810a424809c5db223ed6838e2151aae58bc9488d7724d55bc1e0970416155422ba5b62c762d4a683404bb1447be2ffc3e46f05547cb888ec2a214c72287b8f8e9f4e0b1c8f8d21dc33e46595c75c7bab8a913455ed53dbb722cdb40712255b72022a1fa7aea545d8fe3637468f99a6ef955b7213da9fa3288e42cf28b0124e03e7623e1921bdabe75f05ddb1150234f32e02f41b6417171891e29fc4ead441c8dccc8ba5886159eaa6e117d875d8721b0329b5f82edc6ff3f75f91b59085fc6a21d845c0c376ab91151605d1c7d84872d9846b89c0dfa67d5ed0adb00d954b287f04f0b6704903f537a9706dfb2bf712c618003c3e01f1c1d146be898a20b7f67516a9928724ad37dab2aa384fe897ed3e3255c249fcfae71df55bad6d212c3752bef50eb77f51d6cbbafbd9d1c4ee45e68a52e9d3788fe94edc3bdece857e5c844492bc3f159f767cbe03f001d530d4e01391df95f2dc38eb85f94f0d1df24ed8bbb824e23f79bae75e2bb8695fc5c820e98dc534d3f1f098ec2c2f07c47f88dc3d5685ecf3f3e11537cfe572b4b0f9d47f1f757fcf3ef0c3f012706fec07190272b34a3634bb0f2939868cc8fbaedfba0e2c8bf767aedfe0d68343444d9df0b4783a81b8019bad1d4957b606fcc1770d20c93961926442d6a002cba8f6ec2c5c7e872bd01987b95eaa08873a9ea36f888708e42d5dd1a4f512d28ef30aa052af88d6ec211f7d1d9d23c48e9146a04a1bccd7f0327e09337b90bc4589d5f60699c1c10cee5e42e9093cd5dc1599ddc05103e4dee37cdef6497fe55a85df4cfaa044fa27d57c50ade15a61b68698a22db93bf81ebf66ae3cceac2ebee0ddb4a8f566a38c7167c1d84636b49621dd31b4041647835aed7dc59dce4d56974fabcd327c16c5e6e2432f31bec120b2a5152c153ee26e368d5423bffab7548880444132fffdf296a85f2ec59f52a5cb2ebf7e4fbb702cd0d3f5f03ff8eea7322282d03f0a5fdf03bcbce3dfe8ab273688eff3155e7beb43b8fedf6970fa4ffffa93af71f5275aee2e8e4c5e6e6ab762a0583b3baed3b86ec3d2adf9eb367e9e102c5ddd4e0eba3e40e6d53f6dba8c816a9c9c9d7dd5cca9b54bf2a33451a79eaeee5d508fc33ad01aab8e87a17fac6423195efeef0327f1d66cfc318fa1fa0ebdfed2855a0bef1b7c89ea3fe870baeee727cdeef9691f116cd146978d2e48da34f96d1773bb2156968abdd4d9bfead48a3f67e6bbee5bf87a922939e3b4306f3666e3be97e37ed7f7747a3f59367d33169c118f85e042b0fceea6e4aeae8fade777714e11e380e2ece7666639ef7bba9a36f376df23de43abc1fadd3a382fbfdcfc8755b914631ac0fa98bce5f7f237d401fd93cc8b7912278ac50517e0fe6bac9cc6dbbf00cbcff820abea9bb97b7d23de84fbf5dc67ab07933f26fb9aabb97b3de853e2acfc278cfafc333f76de81a1468dbf7a6b13121b572c72f9d7df7c5377acfdc37c1fc70bf203d5c40a1b6013feecbb721b78735e34ef88264509c2bb1515ffe85f81ca98d9c99dfa04f5a7bb6ef0e52431e64502810e3105ddf31dba70514eb592f7d351877f4c972257c37deb747d4e325d8bc9a048f16c1d85b07d0b37cf3668ea73ec14f74cf247ddaa04f0c148acac71a5da17892112ee3e2daeabb97b0b806741c5a304ab2a39bf55d713116eb4f35963fd558fe5463f9538de54f35963fd558fe5463f9538de54f3596ffa86a2cff9b9d3b3402000061203639bb636ae0d0a8d8aef0bd8c63c79e1384ae39cd86c64263a1b1d058682c34161a0b8d85c64263a1b1d058682c34161a0b8d85c64263a1b1d058682c34161a0b8d85c64263a1b1d058682c34161a0b8d85c64263a1b1d058682c34161a0b8d85c64263a1b1d058682c34161a0b8d85c64263a1b1bc6a2cd5000000ffff030035f2c44c0e340500`)))
@@ -0,0 +1,540 @@
+package gobay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+const (
+	configName       = "config"
+	configFragDir    = "config.d"
+	envConfigPrefix  = "GOBAY_"
+	envKeySeparator  = "__"
+	kubernetesDataFn = "..data"
+)
+
+// supported config file types, in lookup order
+var configTypes = []string{"yaml", "yml", "json", "toml"}
+
+// ConfigWatcher is implemented by extensions which want to apply config
+// changes without restarting. Returning an error rejects the new config.
+type ConfigWatcher interface {
+	OnConfigChange(old, new *viper.Viper) error
+}
+
+// ConfigDiff lists the keys changed by a config reload
+type ConfigDiff struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+// Empty returns true if nothing changed
+func (c ConfigDiff) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// ConfigSourceKind is the kind of the source of a config value
+type ConfigSourceKind string
+
+const (
+	ConfigFromFile     ConfigSourceKind = "file"     // Origin is the file name
+	ConfigFromReader   ConfigSourceKind = "reader"   // CreateAppFromReader
+	ConfigFromMap      ConfigSourceKind = "map"      // CreateAppFromMap
+	ConfigFromEnv      ConfigSourceKind = "env"      // Origin is the env var
+	ConfigFromOverride ConfigSourceKind = "override" // WithConfigOverrides
+	ConfigFromDefault  ConfigSourceKind = "default"  // Origin is the extension key, or gobay
+)
+
+// ConfigSource tells where an effective config value comes from
+type ConfigSource struct {
+	Kind   ConfigSourceKind `json:"kind"`
+	Origin string           `json:"origin,omitempty"`
+}
+
+// ConfigEntry is an effective config value and its source
+type ConfigEntry struct {
+	Key      string       `json:"key"`
+	Value    interface{}  `json:"value"`
+	Source   ConfigSource `json:"source"`
+	Redacted bool         `json:"redacted,omitempty"`
+}
+
+// configSources records the source of every config key, later sources
+// replace earlier ones
+type configSources map[string]ConfigSource
+
+func (s configSources) set(keys []string, source ConfigSource) {
+	for _, key := range keys {
+		s[key] = source
+	}
+}
+
+// setDefault records source for key if key is not set by other sources
+func (s configSources) setDefault(key string, source ConfigSource) {
+	if _, ok := s[key]; !ok {
+		s[key] = source
+	}
+}
+
+// loadConfig loads config from these sources, later ones take precedence:
+//
+//  1. the base file <root>/config.{yaml,yml,json,toml}
+//  2. fragments <root>/config.d/*.{yaml,yml,json,toml} in lexical order
+//  3. the env override file <root>/config.<env>.{yaml,yml,json,toml}
+//  4. environment variables prefixed with GOBAY_, e.g. GOBAY_CACHE_HOST,
+//     except GOBAY_ENV which selects the env in the generated projects.
+//     "__" separates the nested keys, e.g. GOBAY_REDIS__HOST is redis.host.
+//     The values are strings, which are converted by the typed getters of
+//     viper and the config schemas.
+//  5. overrides passed to CreateApp by WithConfigOverrides
+//
+// The base file and fragments contain a sub-tree for every env, while the
+// env override file contains the keys of its env directly. ${VAR} in files
+// are expanded with environment variables.
+//
+// Apps created by CreateAppFromReader and CreateAppFromMap replace the
+// sources 1-3 with the given config.
+//
+// Values like ${file:/run/secrets/db_url} are resolved by the registered
+// SecretResolver at last, and the keys are returned as secrets.
+//
+// The source of every key is recorded for ExplainConfig.
+func (d *Application) loadConfig() (*viper.Viper, map[string]bool, configSources, error) {
+	sources := make(configSources)
+	config, err := d.readConfig(sources)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, kv := range os.Environ() {
+		if key, value, ok := envConfig(kv); ok {
+			config.Set(key, value)
+			sources.set([]string{key}, ConfigSource{Kind: ConfigFromEnv, Origin: kv[:strings.Index(kv, "=")]})
+		}
+	}
+	for key, value := range d.overrides {
+		config.Set(key, value)
+		sources.set([]string{key}, ConfigSource{Kind: ConfigFromOverride})
+	}
+
+	// add default config
+	for key, value := range map[string]interface{}{
+		"debug":                false,
+		"testing":              false,
+		"timezone":             "UTC",
+		"grpc_listen_host":     "localhost",
+		"grpc_listen_port":     6000,
+		"openapi_listen_host":  "localhost",
+		"openapi_listen_port":  3000,
+		"lifecycle_timeout":    defaultLifecycleTimeout,
+		"init_timeout":         defaultInitTimeout,
+		"log_level":            defaultLogLevel,
+		"log_format":           defaultLogFormat,
+		"log_output":           defaultLogOutput,
+		"metrics_listen_host":  "",
+		"metrics_listen_port":  0,
+		"health_check_timeout": defaultHealthCheckTimeout,
+		"health_cache_ttl":     defaultHealthCacheTTL,
+		"admin_listen_host":    "localhost",
+		"admin_listen_port":    0,
+	} {
+		config.SetDefault(key, value)
+		sources.setDefault(key, ConfigSource{Kind: ConfigFromDefault, Origin: "gobay"})
+	}
+
+	secrets, err := resolveSecrets(config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return config, secrets, sources, nil
+}
+
+// readConfig reads the config of d.env from the config source of the app
+func (d *Application) readConfig(sources configSources) (*viper.Viper, error) {
+	if d.configMap != nil {
+		config := viper.New()
+		if err := config.MergeConfigMap(expandEnvValue(d.configMap).(map[string]interface{})); err != nil {
+			return nil, err
+		}
+		sources.set(config.AllKeys(), ConfigSource{Kind: ConfigFromMap})
+		return config, nil
+	}
+
+	root := viper.New()
+	if d.configData != nil {
+		keys, err := mergeConfig(root, "yaml", "config", d.configData)
+		if err != nil {
+			return nil, err
+		}
+		sources.set(envKeys(keys, d.env), ConfigSource{Kind: ConfigFromReader})
+		return subConfig(root, d.env)
+	}
+
+	fsys := d.configFS()
+	baseFile, err := findConfigFile(fsys, configName)
+	if err != nil {
+		return nil, err
+	}
+	if baseFile == "" {
+		return nil, fmt.Errorf("no config file found in %s", d.rootPath)
+	}
+	fragments, err := findConfigFragments(fsys, configFragDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, fn := range append([]string{baseFile}, fragments...) {
+		keys, err := mergeConfigFile(root, fsys, fn)
+		if err != nil {
+			return nil, err
+		}
+		sources.set(envKeys(keys, d.env), ConfigSource{Kind: ConfigFromFile, Origin: fn})
+	}
+	config, err := subConfig(root, d.env)
+	if err != nil {
+		return nil, err
+	}
+	envFile, err := findConfigFile(fsys, configName+"."+d.env)
+	if err != nil {
+		return nil, err
+	}
+	if envFile != "" {
+		keys, err := mergeConfigFile(config, fsys, envFile)
+		if err != nil {
+			return nil, err
+		}
+		sources.set(keys, ConfigSource{Kind: ConfigFromFile, Origin: envFile})
+	}
+	return config, nil
+}
+
+// envConfig returns the config key and value of an environment variable
+// kv, ok is false if it is not a config override
+func envConfig(kv string) (key, value string, ok bool) {
+	name, value, found := strings.Cut(kv, "=")
+	if !found || !strings.HasPrefix(name, envConfigPrefix) || len(name) == len(envConfigPrefix) {
+		return "", "", false
+	}
+	if name == envConfigPrefix+"ENV" {
+		return "", "", false
+	}
+	key = strings.ToLower(name[len(envConfigPrefix):])
+	return strings.ReplaceAll(key, envKeySeparator, "."), value, true
+}
+
+// envKeys returns the keys under env with the env prefix trimmed
+func envKeys(keys []string, env string) []string {
+	var res []string
+	for _, key := range keys {
+		if strings.HasPrefix(key, env+".") {
+			res = append(res, key[len(env)+1:])
+		}
+	}
+	return res
+}
+
+// configFS returns the file system holding the config files, which is the
+// rootPath unless the app is created by CreateAppFromFS
+func (d *Application) configFS() fs.FS {
+	if d.fsys != nil {
+		return d.fsys
+	}
+	return os.DirFS(d.rootPath)
+}
+
+func subConfig(root *viper.Viper, env string) (*viper.Viper, error) {
+	config := root.Sub(env)
+	if config == nil {
+		return nil, fmt.Errorf("no config found for env %s", env)
+	}
+	return config, nil
+}
+
+// findConfigFile returns the first existing <name>.<type> in fsys, or "" if
+// none exists
+func findConfigFile(fsys fs.FS, name string) (string, error) {
+	for _, configType := range configTypes {
+		fn := name + "." + configType
+		if _, err := fs.Stat(fsys, fn); err == nil {
+			return fn, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+func findConfigFragments(fsys fs.FS, dir string) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var fragments []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isConfigType(entry.Name()) {
+			fragments = append(fragments, path.Join(dir, entry.Name()))
+		}
+	}
+	// fs.ReadDir returns entries sorted by filename
+	return fragments, nil
+}
+
+func isConfigType(filename string) bool {
+	ext := strings.TrimPrefix(filepath.Ext(filename), ".")
+	for _, configType := range configTypes {
+		if ext == configType {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeConfigFile(config *viper.Viper, fsys fs.FS, name string) ([]string, error) {
+	originConfig, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	return mergeConfig(config, strings.TrimPrefix(path.Ext(name), "."), name, originConfig)
+}
+
+// mergeConfig merges originConfig into config, and returns the keys set by
+// originConfig
+func mergeConfig(config *viper.Viper, configType, name string, originConfig []byte) ([]string, error) {
+	renderedConfig := []byte(expandEnv(string(originConfig)))
+	layer := viper.New()
+	layer.SetConfigType(configType)
+	if err := layer.ReadConfig(bytes.NewBuffer(renderedConfig)); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if err := config.MergeConfigMap(layer.AllSettings()); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return layer.AllKeys(), nil
+}
+
+// expandEnvValue returns a copy of value with ${VAR} in strings expanded
+func expandEnvValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return expandEnv(v)
+	case map[string]interface{}:
+		expanded := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			expanded[key] = expandEnvValue(item)
+		}
+		return expanded
+	case map[interface{}]interface{}:
+		expanded := make(map[interface{}]interface{}, len(v))
+		for key, item := range v {
+			expanded[key] = expandEnvValue(item)
+		}
+		return expanded
+	case []interface{}:
+		expanded := make([]interface{}, len(v))
+		for i, item := range v {
+			expanded[i] = expandEnvValue(item)
+		}
+		return expanded
+	case []string:
+		expanded := make([]string, len(v))
+		for i, item := range v {
+			expanded[i] = expandEnv(item)
+		}
+		return expanded
+	}
+	return value
+}
+
+func (d *Application) setConfig(config *viper.Viper, secrets map[string]bool, sources configSources) {
+	d.configMu.Lock()
+	defer d.configMu.Unlock()
+	d.config = config
+	d.secrets = secrets
+	d.sources = sources
+}
+
+// ExplainConfig returns every effective config key with its value and
+// source, sorted by key. Secret values are redacted like RedactedConfig.
+func (d *Application) ExplainConfig() []ConfigEntry {
+	config := d.Config()
+	d.configMu.RLock()
+	sources := d.sources
+	d.configMu.RUnlock()
+	keys := config.AllKeys()
+	sort.Strings(keys)
+	entries := make([]ConfigEntry, 0, len(keys))
+	for _, key := range keys {
+		entry := ConfigEntry{Key: key, Value: config.Get(key), Source: sources[key]}
+		if d.IsSecret(key) {
+			entry.Value, entry.Redacted = RedactedValue, true
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// ReloadConfig reads the config file again and notify the extensions which
+// implement ConfigWatcher in init order. If the file can not be loaded, is
+// invalid for the config schemas or any extension rejects the change, the
+// extensions already notified are rolled back and the current config is
+// kept.
+func (d *Application) ReloadConfig() (ConfigDiff, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.initialized || d.closed {
+		return ConfigDiff{}, errors.New("application is not running")
+	}
+	newConfig, secrets, sources, err := d.loadConfig()
+	if err != nil {
+		return ConfigDiff{}, err
+	}
+	if err := d.validateConfig(newConfig, sources); err != nil {
+		return ConfigDiff{}, err
+	}
+	// log_level is applied on reload, reject it like setupLogger
+	if _, err := logLevelOf(newConfig); err != nil {
+		return ConfigDiff{}, err
+	}
+	oldConfig := d.Config()
+	diff := diffConfig(oldConfig, newConfig)
+	if diff.Empty() {
+		return diff, nil
+	}
+
+	var applied []ConfigWatcher
+	for _, key := range d.order {
+		watcher, ok := d.extensions[key].(ConfigWatcher)
+		if !ok {
+			continue
+		}
+		if err := watcher.OnConfigChange(oldConfig, newConfig); err != nil {
+			for i := len(applied) - 1; i >= 0; i-- {
+				// best effort, the old config has been accepted before
+				_ = applied[i].OnConfigChange(newConfig, oldConfig)
+			}
+			return diff, fmt.Errorf("%s: %w", key, err)
+		}
+		applied = append(applied, watcher)
+	}
+	d.setConfig(newConfig, secrets, sources)
+	d.reloadLogger()
+	return diff, nil
+}
+
+// WatchConfig reloads config when the config file changes or the process
+// receives SIGHUP, until ctx is done. onReload is called after every reload
+// attempt, it can be nil. Only apps created by CreateApp can be watched.
+func (d *Application) WatchConfig(ctx context.Context, onReload func(ConfigDiff, error)) error {
+	if d.rootPath == "" {
+		return errors.New("no config directory to watch")
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// watch the directories, editors and kubernetes configmaps replace the file
+	if err := watcher.Add(d.rootPath); err != nil {
+		watcher.Close()
+		return err
+	}
+	fragDir := filepath.Join(d.rootPath, configFragDir)
+	if info, err := os.Stat(fragDir); err == nil && info.IsDir() {
+		if err := watcher.Add(fragDir); err != nil {
+			watcher.Close()
+			return err
+		}
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	go func() {
+		defer watcher.Close()
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !d.isConfigEvent(event) {
+					continue
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				if onReload != nil {
+					onReload(ConfigDiff{}, err)
+				}
+				continue
+			}
+			diff, err := d.ReloadConfig()
+			if onReload != nil {
+				onReload(diff, err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (d *Application) isConfigEvent(event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+		return false
+	}
+	name := filepath.Base(event.Name)
+	// kubernetes updates configmaps by swapping the ..data symlink
+	if name == kubernetesDataFn {
+		return true
+	}
+	if !isConfigType(name) {
+		return false
+	}
+	if filepath.Base(filepath.Dir(event.Name)) == configFragDir {
+		return true
+	}
+	return strings.HasPrefix(name, configName+".")
+}
+
+func diffConfig(old, new *viper.Viper) ConfigDiff {
+	diff := ConfigDiff{}
+	oldKeys := make(map[string]bool)
+	for _, key := range old.AllKeys() {
+		oldKeys[key] = true
+	}
+	for _, key := range new.AllKeys() {
+		if !oldKeys[key] {
+			diff.Added = append(diff.Added, key)
+			continue
+		}
+		delete(oldKeys, key)
+		if !reflect.DeepEqual(old.Get(key), new.Get(key)) {
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+	for key := range oldKeys {
+		diff.Removed = append(diff.Removed, key)
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	return diff
+}
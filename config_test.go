@@ -0,0 +1,225 @@
+package gobay
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type watchExtension struct {
+	orderExtension
+	err     error
+	changes [][2]string
+}
+
+func (e *watchExtension) OnConfigChange(old, new *viper.Viper) error {
+	e.changes = append(e.changes, [2]string{old.GetString("name"), new.GetString("name")})
+	return e.err
+}
+
+func writeConfig(t *testing.T, dir, content string) {
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	writeConfig(t, dir, "testing:\n  name: old\n  removed: 1\n")
+	var events []string
+	first := &watchExtension{orderExtension: orderExtension{key: "first", events: &events}}
+	second := &watchExtension{orderExtension: orderExtension{key: "second", deps: []Key{"first"}, events: &events}}
+	app, err := CreateApp(dir, "testing", map[Key]Extension{"first": first, "second": second})
+	assert.Nil(err)
+
+	// nothing changed
+	diff, err := app.ReloadConfig()
+	assert.Nil(err)
+	assert.True(diff.Empty())
+	assert.Empty(first.changes)
+
+	// rejected by extension, keep the old config
+	writeConfig(t, dir, "testing:\n  name: new\n  added: 1\n")
+	second.err = errors.New("bad config")
+	diff, err = app.ReloadConfig()
+	assert.EqualError(err, "second: bad config")
+	assert.Equal(ConfigDiff{Added: []string{"added"}, Removed: []string{"removed"}, Changed: []string{"name"}}, diff)
+	assert.Equal([][2]string{{"old", "new"}, {"new", "old"}}, first.changes)
+	assert.Equal("old", app.Config().GetString("name"))
+
+	// invalid file, keep the old config
+	writeConfig(t, dir, "testing: [")
+	_, err = app.ReloadConfig()
+	assert.NotNil(err)
+	assert.Equal("old", app.Config().GetString("name"))
+
+	// success
+	writeConfig(t, dir, "testing:\n  name: new\n")
+	second.err = nil
+	first.changes = nil
+	_, err = app.ReloadConfig()
+	assert.Nil(err)
+	assert.Equal([][2]string{{"old", "new"}}, first.changes)
+	assert.Equal("new", app.Config().GetString("name"))
+}
+
+func TestWatchConfig(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	writeConfig(t, dir, "testing:\n  name: old\n")
+	app, err := CreateApp(dir, "testing", map[Key]Extension{})
+	assert.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloaded := make(chan ConfigDiff, 10)
+	err = app.WatchConfig(ctx, func(diff ConfigDiff, err error) {
+		if err == nil && !diff.Empty() {
+			reloaded <- diff
+		}
+	})
+	assert.Nil(err)
+
+	writeConfig(t, dir, "testing:\n  name: new\n")
+	select {
+	case diff := <-reloaded:
+		assert.Equal([]string{"name"}, diff.Changed)
+	case <-time.After(3 * time.Second):
+		t.Fatal("config not reloaded")
+	}
+	assert.Equal("new", app.Config().GetString("name"))
+}
+
+func TestLayeredConfig(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	writeConfig(t, dir, "testing:\n  a: base\n  b: base\n  c: base\n  d: base\n  e: base\n  f: base\n")
+	assert.Nil(os.Mkdir(filepath.Join(dir, "config.d"), 0755))
+	files := map[string]string{
+		"config.d/01.json":    `{"testing": {"b": "fragment", "c": "fragment"}}`,
+		"config.d/02.toml":    "[testing]\nc = \"fragment2\"\nd = \"fragment2\"\n",
+		"config.d/ignore.txt": "testing: {e: ignored}",
+		"config.testing.yaml": "d: env\ne: env\nf: env\n",
+	}
+	for name, content := range files {
+		assert.Nil(os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	t.Setenv("GOBAY_E", "envvar")
+	t.Setenv("GOBAY_F", "envvar")
+	t.Setenv("GOBAY_ENV", "production")
+	t.Setenv("GOBAY_NESTED__KEY", "envvar")
+
+	app, err := CreateApp(dir, "testing", map[Key]Extension{},
+		WithConfigOverrides(map[string]interface{}{"F": "override"}))
+	assert.Nil(err)
+	config := app.Config()
+	assert.Equal("base", config.GetString("a"))
+	assert.Equal("fragment", config.GetString("b"))
+	assert.Equal("fragment2", config.GetString("c"))
+	assert.Equal("env", config.GetString("d"))
+	assert.Equal("envvar", config.GetString("e"))
+	assert.Equal("override", config.GetString("f"))
+	assert.Equal("envvar", config.GetString("nested.key"))
+	assert.False(config.IsSet("env"))
+}
+
+func TestCreateAppFromReader(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv("GOBAY_TEST_NAME", "reader")
+	var events []string
+	ext := &orderExtension{key: "ext", events: &events}
+	app, err := CreateAppFromReader(strings.NewReader(`
+testing:
+  name: ${GOBAY_TEST_NAME}
+  debug: true
+`), "testing", map[Key]Extension{"ext": ext})
+	assert.Nil(err)
+	config := app.Config()
+	assert.Equal("reader", config.GetString("name"))
+	assert.True(config.GetBool("debug"))
+	assert.Equal("UTC", config.GetString("timezone"))
+	assert.Equal([]string{"init ext"}, events)
+
+	_, err = CreateAppFromReader(strings.NewReader("testing: {}"), "production", map[Key]Extension{})
+	assert.EqualError(err, "no config found for env production")
+}
+
+func TestCreateAppFromFS(t *testing.T) {
+	assert := assert.New(t)
+	fsys := fstest.MapFS{
+		"config.yaml":         {Data: []byte("testing:\n  a: base\n  b: base\n")},
+		"config.d/01.json":    {Data: []byte(`{"testing": {"b": "fragment"}}`)},
+		"config.testing.yaml": {Data: []byte("c: env\n")},
+	}
+	app, err := CreateAppFromFS(fsys, "testing", map[Key]Extension{})
+	assert.Nil(err)
+	config := app.Config()
+	assert.Equal("base", config.GetString("a"))
+	assert.Equal("fragment", config.GetString("b"))
+	assert.Equal("env", config.GetString("c"))
+	assert.Equal(6000, config.GetInt("grpc_listen_port"))
+
+	_, err = CreateAppFromFS(fstest.MapFS{}, "testing", map[Key]Extension{})
+	assert.Error(err)
+}
+
+func TestCreateAppFromMap(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv("GOBAY_TEST_HOST", "127.0.0.1")
+	config := map[string]interface{}{
+		"Name":  "map",
+		"cache": map[string]interface{}{"host": "${GOBAY_TEST_HOST}"},
+	}
+	app, err := CreateAppFromMap(config, map[Key]Extension{},
+		WithConfigOverrides(map[string]interface{}{"debug": true}))
+	assert.Nil(err)
+	assert.Equal("", app.Env())
+	assert.Equal("map", app.Config().GetString("name"))
+	assert.Equal("127.0.0.1", app.Config().GetString("cache.host"))
+	assert.True(app.Config().GetBool("debug"))
+	assert.Equal("localhost", app.Config().GetString("openapi_listen_host"))
+	// the given map is not modified
+	assert.Equal("${GOBAY_TEST_HOST}", config["cache"].(map[string]interface{})["host"])
+	assert.Error(app.WatchConfig(context.Background(), nil))
+}
+
+func TestExplainConfig(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv("GOBAY_DB_PORT", "3307")
+	fsys := fstest.MapFS{
+		"config.yaml":         {Data: []byte("testing:\n  db_host: base\n  cache_password: pass\n  nested:\n    a: base\n    b: base\n")},
+		"config.d/01.yaml":    {Data: []byte("testing:\n  nested:\n    b: fragment\n")},
+		"config.testing.yaml": {Data: []byte("db_port: 3306\ncache_url: mysql://user:pass@db\n")},
+	}
+	var events []string
+	exts := map[Key]Extension{"db": &schemaExtension{orderExtension{key: "db", events: &events}, "db_"}}
+	app, err := CreateAppFromFS(fsys, "testing", exts, WithConfigOverrides(map[string]interface{}{"DB_Tags": "a,b"}))
+	assert.Nil(err)
+
+	entries := make(map[string]ConfigEntry)
+	for _, entry := range app.ExplainConfig() {
+		entries[entry.Key] = entry
+	}
+	assert.Equal(ConfigEntry{Key: "db_host", Value: "base", Source: ConfigSource{Kind: ConfigFromFile, Origin: "config.yaml"}}, entries["db_host"])
+	assert.Equal(ConfigEntry{Key: "nested.a", Value: "base", Source: ConfigSource{Kind: ConfigFromFile, Origin: "config.yaml"}}, entries["nested.a"])
+	assert.Equal(ConfigEntry{Key: "nested.b", Value: "fragment", Source: ConfigSource{Kind: ConfigFromFile, Origin: "config.d/01.yaml"}}, entries["nested.b"])
+	assert.Equal(ConfigEntry{Key: "db_port", Value: "3307", Source: ConfigSource{Kind: ConfigFromEnv, Origin: "GOBAY_DB_PORT"}}, entries["db_port"])
+	assert.Equal(ConfigEntry{Key: "db_tags", Value: "a,b", Source: ConfigSource{Kind: ConfigFromOverride}}, entries["db_tags"])
+	assert.Equal(ConfigEntry{Key: "db_timeout", Value: "3s", Source: ConfigSource{Kind: ConfigFromDefault, Origin: "db"}}, entries["db_timeout"])
+	assert.Equal(ConfigEntry{Key: "timezone", Value: "UTC", Source: ConfigSource{Kind: ConfigFromDefault, Origin: "gobay"}}, entries["timezone"])
+	assert.Equal(ConfigEntry{Key: "cache_password", Value: RedactedValue, Source: ConfigSource{Kind: ConfigFromFile, Origin: "config.yaml"}, Redacted: true}, entries["cache_password"])
+	assert.Equal(ConfigEntry{Key: "cache_url", Value: RedactedValue, Source: ConfigSource{Kind: ConfigFromFile, Origin: "config.testing.yaml"}, Redacted: true}, entries["cache_url"])
+
+	app, err = CreateAppFromMap(map[string]interface{}{"name": "map"}, map[Key]Extension{})
+	assert.Nil(err)
+	assert.Contains(app.ExplainConfig(), ConfigEntry{Key: "name", Value: "map", Source: ConfigSource{Kind: ConfigFromMap}})
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"strconv"
@@ -18,15 +19,18 @@ import (
 	"github.com/RichardKnop/machinery/v1"
 	"github.com/RichardKnop/machinery/v1/backends/result"
 	machineryConfig "github.com/RichardKnop/machinery/v1/config"
-	"github.com/RichardKnop/machinery/v1/log"
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/shanbay/gobay"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 const (
 	healthCheckTaskName = "gobay-asynctask-health-check"
+
+	meterName = "github.com/shanbay/gobay/extensions/asynctaskext"
 )
 
 type AsyncTaskExt struct {
@@ -35,8 +39,12 @@ type AsyncTaskExt struct {
 	config  *machineryConfig.Config
 	server  *machinery.Server
 	workers []*machinery.Worker
+	logger  *slog.Logger
+	// number of sent tasks by task name and status
+	sentCounter metric.Int64Counter
 
 	lock                    sync.Mutex
+	healthCheckLock         sync.Mutex
 	healthCheckCompleteChan chan string
 	healthHandlerRegistered bool
 }
@@ -49,11 +57,19 @@ func (t *AsyncTaskExt) Application() *gobay.Application {
 	return t.app
 }
 
+// SetLogger implements gobay.LoggerSetter
+func (t *AsyncTaskExt) SetLogger(logger *slog.Logger) {
+	t.logger = logger
+}
+
 func (t *AsyncTaskExt) Init(app *gobay.Application) error {
 	if t.NS == "" {
 		return errors.New("lack of NS")
 	}
 	t.app = app
+	if t.logger == nil {
+		t.logger = app.Logger()
+	}
 	t.healthCheckCompleteChan = make(chan string, 1)
 	config := app.Config()
 	config = gobay.GetConfigByPrefix(config, t.NS, true)
@@ -62,7 +78,8 @@ func (t *AsyncTaskExt) Init(app *gobay.Application) error {
 		DecoderConfig) {
 		config.TagName = "yaml"
 	}); err != nil {
-		log.FATAL.Printf("parse config error: %v", err)
+		t.logger.Error("parse config error", "error", err)
+		return err
 	}
 
 	server, err := machinery.NewServer(t.config)
@@ -70,9 +87,18 @@ func (t *AsyncTaskExt) Init(app *gobay.Application) error {
 		return err
 	}
 	t.server = server
+	if t.sentCounter, err = app.Meter(meterName).Int64Counter("asynctask_sent_tasks",
+		metric.WithDescription("Number of tasks sent to the broker by status")); err != nil {
+		return err
+	}
 	return t.registerHealthCheck()
 }
 
+// Config returns the machinery config of this extension
+func (t *AsyncTaskExt) Config() *machineryConfig.Config {
+	return t.config
+}
+
 func (t *AsyncTaskExt) Close() error {
 	for _, worker := range t.workers {
 		worker.Quit()
@@ -105,11 +131,14 @@ func (t *AsyncTaskExt) StartWorker(queue string, concurrency int, enableHealthCh
 	// run health check http server
 	if enableHealthCheck && !t.healthHandlerRegistered {
 		t.healthHandlerRegistered = true
-		healthSrv := http.Server{Addr: ":5000"}
-		http.Handle("/health", http.HandlerFunc(t.healthHttpHandler))
+		mux := http.NewServeMux()
+		mux.Handle("/health", http.HandlerFunc(t.healthHttpHandler))
+		mux.Handle("/healthz", t.app.LivenessHandler())
+		mux.Handle("/readyz", t.app.ReadinessHandler())
+		healthSrv := http.Server{Addr: ":5000", Handler: mux}
 		go func() {
 			if err := healthSrv.ListenAndServe(); err != nil {
-				log.FATAL.Printf("error when start prometheus server: %v\n", err)
+				t.logger.Error("error when start health check server", "error", err)
 			}
 		}()
 	}
@@ -121,8 +150,9 @@ func (t *AsyncTaskExt) StartWorker(queue string, concurrency int, enableHealthCh
 //SendTask publish task messages to broker
 func (t *AsyncTaskExt) SendTask(sign *tasks.Signature) (*result.AsyncResult, error) {
 	asyncResult, err := t.server.SendTask(sign)
+	t.recordSent(context.Background(), sign, err)
 	if err != nil {
-		log.ERROR.Printf("send task failed: %v", err)
+		t.logger.Error("send task failed", "task", sign.Name, "error", err)
 		return nil, err
 	}
 	return asyncResult, nil
@@ -131,17 +161,30 @@ func (t *AsyncTaskExt) SendTask(sign *tasks.Signature) (*result.AsyncResult, err
 //SendTask publish task messages with context to broker
 func (t *AsyncTaskExt) SendTaskWithContext(ctx context.Context, sign *tasks.Signature) (*result.AsyncResult, error) {
 	asyncResult, err := t.server.SendTaskWithContext(ctx, sign)
+	t.recordSent(ctx, sign, err)
 	if err != nil {
-		log.ERROR.Printf("send task with context failed: %v", err)
+		t.logger.ErrorContext(ctx, "send task with context failed", "task", sign.Name, "error", err)
 		return nil, err
 	}
 	return asyncResult, nil
 }
 
+func (t *AsyncTaskExt) recordSent(ctx context.Context, sign *tasks.Signature, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	t.sentCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("ns", t.NS),
+		attribute.String("task", sign.Name),
+		attribute.String("status", status),
+	))
+}
+
 func (t *AsyncTaskExt) genConsumerTag(queue string) string {
 	hostName, err := os.Hostname()
 	if err != nil {
-		log.ERROR.Printf("get host name failed: %v", err)
+		t.logger.Error("get host name failed", "error", err)
 	}
 	return fmt.Sprintf("%s@%s", queue, hostName)
 }
@@ -157,8 +200,35 @@ func (t *AsyncTaskExt) registerHealthCheck() error {
 	})
 }
 
+// CheckHealth implements gobay.HealthChecker. It sends a health check to
+// the queue of every worker started in this process and waits until it is
+// processed, so it does nothing in processes which only send tasks.
+func (t *AsyncTaskExt) CheckHealth(ctx context.Context) error {
+	t.lock.Lock()
+	queues := make([]string, 0, len(t.workers))
+	for _, worker := range t.workers {
+		queues = append(queues, worker.Queue)
+	}
+	t.lock.Unlock()
+
+	timeout := 5 * time.Second
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	for _, queue := range queues {
+		if err := t.checkHealth(t.genConsumerTag(queue), timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Send a health check. Expect it to be processed within taskExecutionTimeout, otherwise it is considered unhealthy and return err
 func (t *AsyncTaskExt) checkHealth(consumerTag string, taskExecutionTimeout time.Duration) error {
+	// only one health check can wait for the result at a time
+	t.healthCheckLock.Lock()
+	defer t.healthCheckLock.Unlock()
+
 	// clear channel
 	select {
 	case <-t.healthCheckCompleteChan:
@@ -6,7 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"sync"
 	"time"
@@ -32,8 +32,10 @@ const (
 	defaultPrefetch       = 100
 	defaultPublishRetry   = 3
 	defaultPushTimeout    = "5s"
+	defaultConnectTimeout = 30 * time.Second
 	healthCheckRetryTimes      = 10
 	healthCheckRetryInterval   = 1 * time.Second
+	readyCheckInterval         = 10 * time.Millisecond
 )
 
 type customLoggerInterface interface {
@@ -51,6 +53,7 @@ type BusExt struct {
 	notifyChanClose chan *amqp.Error
 	notifyConfirm   chan amqp.Confirmation
 	isReady         bool
+	readyM          sync.Mutex // guards isReady
 	config          *viper.Viper
 	consumers       map[string]Handler
 	consumeChannels map[string]<-chan amqp.Delivery
@@ -60,6 +63,9 @@ type BusExt struct {
 	reconnectDelay  time.Duration
 	reinitDelay     time.Duration
 	pushM           sync.Mutex
+	logger          *slog.Logger
+	// ErrorLogger receives the error logs instead of the logger of the app
+	// if it is set, e.g. to capture them by sentry
 	ErrorLogger     customLoggerInterface
 	mocked          bool
 	pushTimeout     time.Duration
@@ -68,6 +74,20 @@ type BusExt struct {
 	publishFunc     func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
 	brokerUrl       string
 	notifyChanBlock chan error
+	metrics         *busMetrics
+}
+
+// ready returns whether BusExt is connected to the broker
+func (b *BusExt) ready() bool {
+	b.readyM.Lock()
+	defer b.readyM.Unlock()
+	return b.isReady
+}
+
+func (b *BusExt) setReady(ready bool) {
+	b.readyM.Lock()
+	defer b.readyM.Unlock()
+	b.isReady = ready
 }
 
 func (b *BusExt) Object() interface{} {
@@ -78,11 +98,19 @@ func (b *BusExt) Application() *gobay.Application {
 	return b.app
 }
 
+// SetLogger implements gobay.LoggerSetter
+func (b *BusExt) SetLogger(logger *slog.Logger) {
+	b.logger = logger
+}
+
 func (b *BusExt) Init(app *gobay.Application) error {
 	if b.NS == "" {
 		return errors.New("lack of NS")
 	}
 	b.app = app
+	if b.logger == nil {
+		b.logger = app.Logger()
+	}
 	config := app.Config()
 	b.config = gobay.GetConfigByPrefix(config, b.NS, true)
 	setDefaultConfig(b.config)
@@ -98,11 +126,16 @@ func (b *BusExt) Init(app *gobay.Application) error {
 	b.pushTimeout = b.config.GetDuration("push_timeout")
 	b.pushFunc = b.doPush
 	b.notifyChanBlock = make(chan error)
+	metrics, err := newBusMetrics(app.Meter(meterName), b.NS)
+	if err != nil {
+		return err
+	}
+	b.metrics = metrics
 
 	var tlsConfig *tls.Config
 	if b.config.GetBool("tls") {
 		if err := b.config.UnmarshalKey("TLSConfig", &tlsConfig); err != nil {
-			b.ErrorLogger.Printf("unmarshal TLSConfig failed: %v\n", err)
+			b.logError("unmarshal TLSConfig failed", "error", err)
 			return err
 		}
 	}
@@ -111,17 +144,36 @@ func (b *BusExt) Init(app *gobay.Application) error {
 	if !b.mocked {
 		go b.handleReconnect(b.brokerUrl, tlsConfig)
 	} else {
-		b.isReady = true
+		b.setReady(true)
 	}
 
-	for {
-		if !b.isReady {
-			continue
-		} else {
-			break
+	connectTimeout := b.config.GetDuration("connect_timeout")
+	if connectTimeout <= 0 {
+		connectTimeout = defaultConnectTimeout
+	}
+	if err := b.waitReady(connectTimeout); err != nil {
+		return err
+	}
+	b.logger.Info("BusExt init done")
+	return nil
+}
+
+// waitReady waits until BusExt is ready, or gives up after timeout and stops
+// reconnecting, so that Init never blocks forever if the broker is down
+func (b *BusExt) waitReady(timeout time.Duration) error {
+	ticker := time.NewTicker(readyCheckInterval)
+	defer ticker.Stop()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for !b.ready() {
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			// stop reconnecting
+			close(b.done)
+			return fmt.Errorf("connect to broker timed out after %v", timeout)
 		}
 	}
-	log.Println("BusExt init done")
 	return nil
 }
 
@@ -129,35 +181,43 @@ func (b *BusExt) Close() error {
 	if b.mocked {
 		return nil
 	}
-	if !b.isReady {
+	if !b.ready() {
 		return errAlreadyClosed
 	}
 	if err := b.channel.Close(); err != nil {
-		b.ErrorLogger.Printf("close channel failed: %v\n", err)
+		b.logError("close channel failed", "error", err)
 		return err
 	}
 	if err := b.connection.Close(); err != nil {
-		b.ErrorLogger.Printf("close connection failed: %v\n", err)
+		b.logError("close connection failed", "error", err)
 		return err
 	}
 
 	close(b.done)
-	b.isReady = false
+	b.setReady(false)
 
-	log.Println("BusExt closed")
+	b.logger.Info("BusExt closed")
 	return nil
 }
 
 func (b *BusExt) Push(exchange, routingKey string, data amqp.Publishing) error {
+	return b.PushContext(context.Background(), exchange, routingKey, data)
+}
+
+// PushContext pushes data like Push, and injects the trace context of ctx
+// into the headers of data, so that the consumer continues the trace.
+func (b *BusExt) PushContext(ctx context.Context, exchange, routingKey string, data amqp.Publishing) (err error) {
 	if b.mocked {
 		return nil
 	}
 	b.pushM.Lock()
 	defer b.pushM.Unlock()
-	if !b.isReady {
-		b.ErrorLogger.Printf("Can not publish message: %v\n", ErrNotReady)
+	if !b.ready() {
+		b.logError("can not publish message", "error", ErrNotReady)
 		return ErrNotReady
 	}
+	endSpan := b.startPublishSpan(ctx, exchange, routingKey, &data)
+	defer func() { endSpan(err) }()
 	result := make(chan error, 1)
 	ctx, cancel := context.WithTimeout(context.Background(), b.pushTimeout)
 	defer cancel()
@@ -166,20 +226,22 @@ func (b *BusExt) Push(exchange, routingKey string, data amqp.Publishing) error {
 	// NOTE END
 	select {
 	case err := <-result:
+		b.metrics.recordPublish(exchange, routingKey, err)
 		if err != nil {
-			b.ErrorLogger.Printf("Push failed: %v\n", err)
+			b.logError("push failed", "error", err)
 			return err
 		}
 		return nil
 	case <-ctx.Done():
-		b.isReady = false
+		b.metrics.recordPublish(exchange, routingKey, ErrTimeout)
+		b.setReady(false)
 		b.notifyChanBlock <- ErrTimeout
 		return ErrTimeout
 	}
 }
 
 func (b *BusExt) doPush(ctx context.Context, exchange, routingKey string, data amqp.Publishing, result chan error) {
-	log.Printf("Trying to publish: %s\n", data.Headers["id"])
+	b.logger.Debug("trying to publish", "id", data.Headers["id"])
 	for i := 0; i < b.publishRetry; i++ {
 		// clear staled confirmnation
 		// 有可能在超时之后才收到 confirm，会堵塞 channel，最终造成死锁
@@ -189,10 +251,10 @@ func (b *BusExt) doPush(ctx context.Context, exchange, routingKey string, data a
 		}
 		err := b.unsafePush(exchange, routingKey, data)
 		if err != nil {
-			b.ErrorLogger.Printf("UnsafePush msg %s failed : %v\n", data.Headers["id"], err)
+			b.logError("unsafe push failed", "id", data.Headers["id"], "error", err)
 			select {
 			case <-b.done:
-				b.ErrorLogger.Println("BusExt closed during publishing message")
+				b.logError("BusExt closed during publishing message", "id", data.Headers["id"])
 				result <- errShutdown
 				return
 			case <-time.After(b.resendDelay):
@@ -202,23 +264,23 @@ func (b *BusExt) doPush(ctx context.Context, exchange, routingKey string, data a
 		select {
 		case confirm := <-b.notifyConfirm:
 			if confirm.Ack {
-				log.Printf("Publish %s confirmed!", data.Headers["id"])
+				b.logger.Debug("publish confirmed", "id", data.Headers["id"])
 				result <- nil
 				return
 			}
 		case <-time.After(b.resendDelay):
 		}
-		log.Printf("Publish %s not confirmed after %f seconds. Retrying...\n",
-			data.Headers["id"], b.resendDelay.Seconds())
+		b.logger.Warn("publish not confirmed, retrying",
+			"id", data.Headers["id"], "resend_delay", b.resendDelay)
 	}
 	err := fmt.Errorf(
 		"publishing message %s failed after retry %d times", data.Headers["id"], b.publishRetry)
-	b.ErrorLogger.Println(err)
+	b.logError("publish failed", "error", err)
 	result <- err
 }
 
 func (b *BusExt) unsafePush(exchange, routingKey string, data amqp.Publishing) error {
-	if !b.isReady {
+	if !b.ready() {
 		return errNotConnected
 	}
 	return b.publishFunc(
@@ -235,16 +297,16 @@ func (b *BusExt) Register(routingKey string, handler Handler) {
 }
 
 func (b *BusExt) Consume() error {
-	if !b.isReady {
-		b.ErrorLogger.Println("can not consume. BusExt is not ready")
+	if !b.ready() {
+		b.logError("can not consume, BusExt is not ready")
 		return errNotConnected
 	}
 	if err := b.channel.Qos(b.prefetch, 0, false); err != nil {
-		b.ErrorLogger.Printf("set qos failed: %v\n", err)
+		b.logError("set qos failed", "error", err)
 	}
 	hostName, err := os.Hostname()
 	if err != nil {
-		b.ErrorLogger.Printf("get host name failed: %v\n", err)
+		b.logError("get host name failed", "error", err)
 	}
 	for _, queue := range b.config.GetStringSlice("queues") {
 		ch, err := b.channel.Consume(
@@ -257,7 +319,7 @@ func (b *BusExt) Consume() error {
 			nil,
 		)
 		if err != nil {
-			b.ErrorLogger.Printf("StartWorker queue: %v failed: %v\n", queue, err)
+			b.logError("start worker failed", "queue", queue, "error", err)
 			return err
 		}
 		b.consumeChannels[queue] = ch
@@ -275,28 +337,37 @@ func (b *BusExt) Consume() error {
 					return
 				case delivery := <-channel:
 					b.deliveryAck(delivery)
-					log.Printf("Receive delivery: %s from queue: %v\n",
-						delivery.Headers["id"], chName)
+					b.logger.Debug("receive delivery",
+						"id", delivery.Headers["id"], "queue", chName)
 					var handler Handler
 					var ok bool
 					if delivery.Headers == nil {
-						b.ErrorLogger.Println("Not support v1 celery protocol yet")
+						b.logError("not support v1 celery protocol yet", "queue", chName)
+						b.metrics.recordConsume(chName, delivery.RoutingKey, statusInvalid, time.Time{})
 					} else if delivery.ContentType != "application/json" {
-						b.ErrorLogger.Println("Only json encoding is allowed")
+						b.logError("only json encoding is allowed", "queue", chName)
+						b.metrics.recordConsume(chName, delivery.RoutingKey, statusInvalid, time.Time{})
 					} else if delivery.ContentEncoding != "utf-8" {
-						b.ErrorLogger.Println("Unsupported content encoding")
+						b.logError("unsupported content encoding", "queue", chName)
+						b.metrics.recordConsume(chName, delivery.RoutingKey, statusInvalid, time.Time{})
 					} else if handler, ok = b.consumers[delivery.RoutingKey]; !ok {
-						b.ErrorLogger.Println("Receive unregistered message")
+						b.logError("receive unregistered message", "queue", chName, "routing_key", delivery.RoutingKey)
+						b.metrics.recordConsume(chName, delivery.RoutingKey, statusInvalid, time.Time{})
 					} else {
+						start := time.Now()
+						ctx, endSpan := b.startConsumeSpan(chName, delivery)
 						var payload []json.RawMessage
-						if err := json.Unmarshal(delivery.Body, &payload); err != nil {
-							b.ErrorLogger.Printf("json decode error: %v\n", err)
-						} else if err := handler.ParsePayload(payload[0],
+						err := json.Unmarshal(delivery.Body, &payload)
+						if err != nil {
+							b.logError("json decode failed", "id", delivery.Headers["id"], "error", err)
+						} else if err = handler.ParsePayload(payload[0],
 							payload[1]); err != nil {
-							b.ErrorLogger.Printf("handler parse payload error: %v\n", err)
-						} else if err := handler.Run(); err != nil {
-							b.ErrorLogger.Printf("handler run task failed: %v\n", err)
+							b.logError("handler parse payload failed", "id", delivery.Headers["id"], "error", err)
+						} else if err = runHandler(ctx, handler); err != nil {
+							b.logError("handler run task failed", "id", delivery.Headers["id"], "error", err)
 						}
+						endSpan(err)
+						b.metrics.recordConsume(chName, delivery.RoutingKey, errStatus(err), start)
 					}
 				}
 			}
@@ -308,13 +379,13 @@ func (b *BusExt) Consume() error {
 
 func (b *BusExt) handleReconnect(brokerUrl string, tlsConfig *tls.Config) {
 	for {
-		b.isReady = false
-		log.Printf("Attempting to connect to %v tlsConfig: %v\n", brokerUrl, tlsConfig)
+		b.setReady(false)
+		b.logger.Info("attempting to connect", "broker_url", b.app.Redact(b.NS+"broker_url", brokerUrl), "tls", tlsConfig != nil)
 
 		conn, err := b.connect(brokerUrl, tlsConfig)
 
 		if err != nil {
-			b.ErrorLogger.Printf("Failed to connect: %v. Retrying...\n", err)
+			b.logError("failed to connect, retrying", "error", err)
 			select {
 			case <-b.done:
 				return
@@ -343,18 +414,18 @@ func (b *BusExt) connect(brokerUrl string, tlsConfig *tls.Config) (*amqp.Connect
 	}
 
 	b.changeConnection(conn)
-	log.Println("Connected!")
+	b.logger.Info("connected")
 	return conn, nil
 }
 
 func (b *BusExt) handleReInit(conn *amqp.Connection) bool {
 	for {
-		b.isReady = false
+		b.setReady(false)
 
 		err := b.init(conn)
 
 		if err != nil {
-			b.ErrorLogger.Printf("Failed to initialize channel: %v. Retrying...\n", err)
+			b.logError("failed to initialize channel, retrying", "error", err)
 
 			select {
 			case <-b.done:
@@ -368,12 +439,12 @@ func (b *BusExt) handleReInit(conn *amqp.Connection) bool {
 		case <-b.done:
 			return true
 		case <-b.notifyConnClose:
-			log.Println("Connection closed. Reconnecting...")
+			b.logger.Warn("connection closed, reconnecting")
 			return false
 		case <-b.notifyChanClose:
-			log.Println("channel closed. Retrying init...")
+			b.logger.Warn("channel closed, retrying init")
 		case <-b.notifyChanBlock:
-			log.Println("Channel blocked. Retrying init...")
+			b.logger.Warn("channel blocked, retrying init")
 		}
 	}
 }
@@ -382,14 +453,14 @@ func (b *BusExt) init(conn *amqp.Connection) error {
 	ch, err := conn.Channel()
 
 	if err != nil {
-		b.ErrorLogger.Printf("create channel failed: %v\n", err)
+		b.logError("create channel failed", "error", err)
 		return err
 	}
 
 	err = ch.Confirm(false)
 
 	if err != nil {
-		b.ErrorLogger.Printf("change to confirm mod failed: %v\n", err)
+		b.logError("change to confirm mode failed", "error", err)
 		return err
 	}
 
@@ -404,10 +475,10 @@ func (b *BusExt) init(conn *amqp.Connection) error {
 			nil)
 
 		if err != nil {
-			b.ErrorLogger.Printf("declare exchange: %v failed: %v\n", exchange, err)
+			b.logError("declare exchange failed", "exchange", exchange, "error", err)
 			return err
 		}
-		log.Printf("declare exchange: %v succeeded\n", exchange)
+		b.logger.Debug("declare exchange succeeded", "exchange", exchange)
 	}
 
 	for _, queue := range b.config.GetStringSlice("queues") {
@@ -421,15 +492,15 @@ func (b *BusExt) init(conn *amqp.Connection) error {
 		)
 
 		if err != nil {
-			b.ErrorLogger.Printf("declare queue: %v failed: %v\n", queue, err)
+			b.logError("declare queue failed", "queue", queue, "error", err)
 			return err
 		}
-		log.Printf("declare queue: %v succeeded\n", queue)
+		b.logger.Debug("declare queue succeeded", "queue", queue)
 	}
 
 	var bs []map[string]string
 	if err := b.config.UnmarshalKey("bindings", &bs); err != nil {
-		b.ErrorLogger.Printf("unmarshal bindings failed: %v\n", err)
+		b.logError("unmarshal bindings failed", "error", err)
 		return err
 	}
 	for _, binding := range bs {
@@ -439,25 +510,25 @@ func (b *BusExt) init(conn *amqp.Connection) error {
 			binding["exchange"],
 			false,
 			nil); err != nil {
-			b.ErrorLogger.Printf("declare binding: %v failed: %v\n", binding, err)
+			b.logError("declare binding failed", "binding", binding, "error", err)
 			return err
 		}
-		log.Printf("declare binding: %v succeeded\n", binding)
+		b.logger.Debug("declare binding succeeded", "binding", binding)
 	}
 
 	b.changeChannel(ch)
-	b.isReady = true
+	b.setReady(true)
 	if len(b.consumers) > 0 {
 		b.consumeChannels = make(map[string]<-chan amqp.Delivery)
 		go func() {
 			err := b.Consume()
 			if err != nil {
-				b.ErrorLogger.Printf("errors occur when consume: %v\n", err)
+				b.logError("consume failed", "error", err)
 			}
 		}()
 	}
 	b.publishFunc = b.channel.Publish
-	log.Println("init finished")
+	b.logger.Debug("init finished")
 
 	return nil
 }
@@ -466,7 +537,7 @@ func (b *BusExt) changeConnection(connection *amqp.Connection) {
 	b.connection = connection
 	b.notifyConnClose = make(chan *amqp.Error)
 	b.connection.NotifyClose(b.notifyConnClose)
-	log.Println("connection changed")
+	b.logger.Debug("connection changed")
 
 }
 
@@ -476,7 +547,7 @@ func (b *BusExt) changeChannel(channel *amqp.Channel) {
 	b.notifyConfirm = make(chan amqp.Confirmation, 5)
 	b.channel.NotifyClose(b.notifyChanClose)
 	b.channel.NotifyPublish(b.notifyConfirm)
-	log.Println("channel changed")
+	b.logger.Debug("channel changed")
 }
 
 func (b *BusExt) deliveryAck(delivery amqp.Delivery) {
@@ -487,12 +558,19 @@ func (b *BusExt) deliveryAck(delivery amqp.Delivery) {
 		}
 	}
 	if err != nil {
-		b.ErrorLogger.Printf("failed to ack delivery: %+v"+
-			": %+v\n",
-			delivery.MessageId, err)
+		b.logError("failed to ack delivery", "message_id", delivery.MessageId, "error", err)
 	}
 }
 
+// logError logs to ErrorLogger if it is set, otherwise to the logger of the app
+func (b *BusExt) logError(msg string, args ...interface{}) {
+	if b.ErrorLogger != nil {
+		b.ErrorLogger.Println(append([]interface{}{msg}, args...)...)
+		return
+	}
+	b.logger.Error(msg, args...)
+}
+
 func setDefaultConfig(v *viper.Viper) {
 	v.SetDefault("prefetch", defaultPrefetch)
 	v.SetDefault("publish_retry", defaultPublishRetry)
@@ -502,12 +580,23 @@ func setDefaultConfig(v *viper.Viper) {
 	v.SetDefault("push_timeout", defaultPushTimeout)
 }
 
+// CheckHealth implements gobay.HealthChecker, it fails if BusExt is not
+// connected to the broker
+func (b *BusExt) CheckHealth(ctx context.Context) error {
+	if b.mocked || b.ready() {
+		return nil
+	}
+	return ErrNotReady
+}
+
+// HealthCheck waits up to healthCheckRetryTimes seconds for BusExt to be
+// ready. Prefer CheckHealth, which is called by the app health checks.
 func (b *BusExt) HealthCheck() error {
 	if b.mocked {
 		return nil
 	}
 	for i := 0; i < healthCheckRetryTimes; i++ {
-		if b.isReady {
+		if b.ready() {
 			return nil
 		}
 		time.Sleep(healthCheckRetryInterval)
@@ -1,6 +1,7 @@
 package busext
 
 import (
+	"context"
 	"encoding/json"
 
 	uuid "github.com/satori/go.uuid"
@@ -13,6 +14,21 @@ type Handler interface {
 	Run() error
 }
 
+// ContextHandler is a Handler which runs with a context carrying the trace
+// of the message, RunContext is called instead of Run
+type ContextHandler interface {
+	Handler
+
+	RunContext(ctx context.Context) error
+}
+
+func runHandler(ctx context.Context, handler Handler) error {
+	if h, ok := handler.(ContextHandler); ok {
+		return h.RunContext(ctx)
+	}
+	return handler.Run()
+}
+
 type Body struct {
 	Args   []interface{}          `json:"args"`
 	Kwargs map[string]interface{} `json:"kwargs"`
@@ -0,0 +1,76 @@
+package busext
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/shanbay/gobay/extensions/busext"
+
+// status of published and consumed messages
+const (
+	statusOK      = "ok"
+	statusError   = "error"
+	statusInvalid = "invalid" // the consumed message can not be handled
+)
+
+// busMetrics records the published and consumed messages of a BusExt
+type busMetrics struct {
+	ns        attribute.KeyValue
+	published metric.Int64Counter
+	consumed  metric.Int64Counter
+	handling  metric.Float64Histogram
+}
+
+func newBusMetrics(meter metric.Meter, ns string) (*busMetrics, error) {
+	m := &busMetrics{ns: attribute.String("ns", ns)}
+	var err error
+	if m.published, err = meter.Int64Counter("bus_published_messages",
+		metric.WithDescription("Number of published messages by status")); err != nil {
+		return nil, err
+	}
+	if m.consumed, err = meter.Int64Counter("bus_consumed_messages",
+		metric.WithDescription("Number of consumed messages by status")); err != nil {
+		return nil, err
+	}
+	if m.handling, err = meter.Float64Histogram("bus_handling",
+		metric.WithDescription("Duration of handling consumed messages"),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *busMetrics) recordPublish(exchange, routingKey string, err error) {
+	m.published.Add(context.Background(), 1, metric.WithAttributes(
+		m.ns,
+		attribute.String("exchange", exchange),
+		attribute.String("routing_key", routingKey),
+		attribute.String("status", errStatus(err)),
+	))
+}
+
+// recordConsume records a consumed message, the handling duration is
+// recorded if start is not zero
+func (m *busMetrics) recordConsume(queue, routingKey, status string, start time.Time) {
+	attrs := metric.WithAttributes(
+		m.ns,
+		attribute.String("queue", queue),
+		attribute.String("routing_key", routingKey),
+		attribute.String("status", status),
+	)
+	m.consumed.Add(context.Background(), 1, attrs)
+	if !start.IsZero() {
+		m.handling.Record(context.Background(), time.Since(start).Seconds(), attrs)
+	}
+}
+
+func errStatus(err error) string {
+	if err != nil {
+		return statusError
+	}
+	return statusOK
+}
@@ -0,0 +1,161 @@
+package busext
+
+import (
+	"context"
+
+	"github.com/shanbay/gobay/observability"
+	"github.com/streadway/amqp"
+	"go.elastic.co/apm"
+	"go.elastic.co/apm/module/apmhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headers of the trace context in messages, celery workers in python read
+// the same lowercase headers
+const (
+	traceparentHeader    = "traceparent"
+	tracestateHeader     = "tracestate"
+	apmTraceparentHeader = "elastic-apm-traceparent"
+)
+
+// headerCarrier adapts the headers of a message to propagation.TextMapCarrier
+type headerCarrier amqp.Table
+
+var _ propagation.TextMapCarrier = headerCarrier(nil)
+
+func (h headerCarrier) Get(key string) string {
+	v, _ := h[key].(string)
+	return v
+}
+
+func (h headerCarrier) Set(key, value string) {
+	h[key] = value
+}
+
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// startPublishSpan starts a producer span of publishing data, and injects
+// the trace context into a copy of the headers of data. The returned func
+// ends the span.
+func (b *BusExt) startPublishSpan(ctx context.Context, exchange, routingKey string, data *amqp.Publishing) func(error) {
+	headers := make(amqp.Table, len(data.Headers)+3)
+	for k, v := range data.Headers {
+		headers[k] = v
+	}
+	data.Headers = headers
+
+	var otelSpan trace.Span
+	if observability.GetOtelEnable() {
+		ctx, otelSpan = otel.Tracer(meterName).Start(ctx, routingKey+" publish",
+			trace.WithSpanKind(trace.SpanKindProducer),
+			trace.WithAttributes(messageAttributes(b.NS, exchange, routingKey, headers)...),
+		)
+		otel.GetTextMapPropagator().Inject(ctx, headerCarrier(headers))
+	}
+	var apmSpan *apm.Span
+	if observability.GetApmEnable() {
+		apmSpan, _ = apm.StartSpan(ctx, routingKey+" publish", "messaging.rabbitmq.send")
+		apmSpan.Context.SetMessage(apm.MessageSpanContext{QueueName: exchange})
+		if tc := apmSpan.TraceContext(); !apmSpan.Dropped() && tc.Trace.Validate() == nil {
+			traceparent := apmhttp.FormatTraceparentHeader(tc)
+			headers[apmTraceparentHeader] = traceparent
+			if _, ok := headers[traceparentHeader]; !ok {
+				headers[traceparentHeader] = traceparent
+				if state := tc.State.String(); state != "" {
+					headers[tracestateHeader] = state
+				}
+			}
+		}
+	}
+	return func(err error) {
+		if otelSpan != nil {
+			endSpan(otelSpan, err)
+		}
+		if apmSpan != nil {
+			if err != nil {
+				apmSpan.Outcome = "failure"
+			}
+			apmSpan.End()
+		}
+	}
+}
+
+// startConsumeSpan extracts the trace context from the headers of delivery,
+// and starts a consumer span and an APM transaction of handling it, linked
+// to the producer. The returned func ends them.
+func (b *BusExt) startConsumeSpan(queue string, delivery amqp.Delivery) (context.Context, func(error)) {
+	ctx := context.Background()
+	carrier := headerCarrier(delivery.Headers)
+	var otelSpan trace.Span
+	if observability.GetOtelEnable() {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+		attrs := append(messageAttributes(b.NS, delivery.Exchange, delivery.RoutingKey, delivery.Headers),
+			attribute.String("messaging.destination.subscription.name", queue))
+		ctx, otelSpan = otel.Tracer(meterName).Start(ctx, delivery.RoutingKey+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithLinks(trace.LinkFromContext(ctx)),
+			trace.WithAttributes(attrs...),
+		)
+	}
+	var tx *apm.Transaction
+	if tracer := observability.ApmTracer(); tracer != nil {
+		var opts apm.TransactionOptions
+		traceparent := carrier.Get(apmTraceparentHeader)
+		if traceparent == "" {
+			traceparent = carrier.Get(traceparentHeader)
+		}
+		if tc, err := apmhttp.ParseTraceparentHeader(traceparent); err == nil {
+			tc.State, _ = apmhttp.ParseTracestateHeader(carrier.Get(tracestateHeader))
+			opts.TraceContext = tc
+		}
+		tx = tracer.StartTransactionOptions(delivery.RoutingKey, "messaging", opts)
+		tx.Context.SetLabel("queue", queue)
+		ctx = apm.ContextWithTransaction(ctx, tx)
+	}
+	return ctx, func(err error) {
+		if otelSpan != nil {
+			endSpan(otelSpan, err)
+		}
+		if tx != nil {
+			if err != nil {
+				tx.Outcome = "failure"
+				tx.Result = statusError
+			} else {
+				tx.Result = statusOK
+			}
+			tx.End()
+		}
+	}
+}
+
+func messageAttributes(ns, exchange, routingKey string, headers amqp.Table) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingDestinationName(exchange),
+		semconv.MessagingRabbitmqDestinationRoutingKey(routingKey),
+		attribute.String("ns", ns),
+	}
+	if id, ok := headers["id"].(string); ok {
+		attrs = append(attrs, semconv.MessagingMessageID(id))
+	}
+	return attrs
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,76 @@
+package busext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracePropagation(t *testing.T) {
+	t.Setenv("OTEL_ENABLE", "true")
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	b := &BusExt{NS: "bus_"}
+	routingKey := "gobay.buses.test"
+	msg, err := BuildMsg(routingKey, []interface{}{}, map[string]interface{}{})
+	assert.Nil(t, err)
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+	data := *msg
+	endPublish := b.startPublishSpan(ctx, "sbay-exchange", routingKey, &data)
+	endPublish(nil)
+	parent.End()
+	// the headers of msg are not changed
+	assert.NotContains(t, msg.Headers, traceparentHeader)
+	assert.Contains(t, data.Headers, traceparentHeader)
+	assert.Equal(t, msg.Headers["id"], data.Headers["id"])
+
+	delivery := amqp.Delivery{Exchange: "sbay-exchange", RoutingKey: routingKey, Headers: data.Headers}
+	ctx, endConsume := b.startConsumeSpan("gobay.buses", delivery)
+	assert.Equal(t, parent.SpanContext().TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+	endConsume(nil)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	publish, consume := spans[0], spans[2]
+	assert.Equal(t, routingKey+" publish", publish.Name())
+	assert.Equal(t, trace.SpanKindProducer, publish.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), publish.Parent().SpanID())
+	assert.Equal(t, routingKey+" process", consume.Name())
+	assert.Equal(t, trace.SpanKindConsumer, consume.SpanKind())
+	assert.Equal(t, publish.SpanContext().SpanID(), consume.Parent().SpanID())
+	assert.Len(t, consume.Links(), 1)
+	assert.Equal(t, publish.SpanContext().SpanID(), consume.Links()[0].SpanContext.SpanID())
+}
+
+type ctxKey struct{}
+
+type contextHandler struct {
+	TestHandler
+	ctx context.Context
+}
+
+func (h *contextHandler) RunContext(ctx context.Context) error {
+	h.ctx = ctx
+	return nil
+}
+
+func TestRunHandler(t *testing.T) {
+	h := &contextHandler{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+	assert.Nil(t, runHandler(ctx, h))
+	assert.Equal(t, ctx, h.ctx)
+}
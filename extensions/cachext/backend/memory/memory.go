@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"errors"
+	"hash/fnv"
 	"sync"
 	"time"
 
@@ -10,49 +12,120 @@ import (
 	"github.com/shanbay/gobay/extensions/cachext"
 )
 
+const (
+	evictionLRU = "lru"
+	evictionLFU = "lfu"
+
+	defaultMaxEntries      = 100000
+	defaultShards          = 16
+	defaultCleanupInterval = time.Minute
+)
+
 func init() {
 	if err := cachext.RegisterBackend("memory", func() cachext.CacheBackend { return &memoryBackend{} }); err != nil {
 		panic("MemoryBackend Init error")
 	}
 }
 
-type memoryBackendNode struct {
-	Value     []byte
-	ExpiredAt time.Time
+// memoryBackend keeps the entries in shards with their own locks. Every
+// shard is bounded by its part of memory_max_entries and memory_max_bytes,
+// and evicts the entries by memory_eviction when it is full. The expired
+// entries are removed when they are read, and by a janitor every
+// memory_cleanup_interval.
+type memoryBackend struct {
+	shards    []*shard
+	stop      chan struct{}
+	closeOnce sync.Once
 }
 
-type memoryBackend struct {
-	lock   sync.Mutex
-	client map[string]*memoryBackendNode
+// Config the config of memory backend, keys are prefixed with the NS of
+// CacheExt
+type Config struct {
+	MaxEntries      int           `mapstructure:"memory_max_entries" default:"100000"`
+	MaxBytes        int64         `mapstructure:"memory_max_bytes"`
+	Eviction        string        `mapstructure:"memory_eviction" default:"lru"`
+	Shards          int           `mapstructure:"memory_shards" default:"16"`
+	CleanupInterval time.Duration `mapstructure:"memory_cleanup_interval" default:"1m"`
 }
 
-func (m *memoryBackend) Init(*viper.Viper) error {
-	m.client = make(map[string]*memoryBackendNode)
+var (
+	_ cachext.Locker              = (*memoryBackend)(nil)
+	_ cachext.StatsReporter       = (*memoryBackend)(nil)
+	_ cachext.ConfigurableBackend = (*memoryBackend)(nil)
+	_ cachext.PrefixDeleter       = (*memoryBackend)(nil)
+)
+
+// ConfigSchema implements cachext.ConfigurableBackend
+func (m *memoryBackend) ConfigSchema() interface{} {
+	return &Config{}
+}
+
+func (m *memoryBackend) Init(config *viper.Viper) error {
+	maxEntries := config.GetInt("memory_max_entries")
+	if maxEntries <= 0 {
+		maxEntries = defaultMaxEntries
+	}
+	maxBytes := config.GetInt64("memory_max_bytes")
+	eviction := config.GetString("memory_eviction")
+	if eviction == "" {
+		eviction = evictionLRU
+	}
+	if eviction != evictionLRU && eviction != evictionLFU {
+		return errors.New("memory_eviction should be lru or lfu: " + eviction)
+	}
+	shards := config.GetInt("memory_shards")
+	if shards <= 0 {
+		shards = defaultShards
+	}
+	interval := config.GetDuration("memory_cleanup_interval")
+	if interval <= 0 {
+		interval = defaultCleanupInterval
+	}
+
+	m.shards = make([]*shard, shards)
+	for i := range m.shards {
+		m.shards[i] = newShard(eviction, ceilDiv(maxEntries, shards), ceilDiv64(maxBytes, int64(shards)))
+	}
+	m.stop = make(chan struct{})
+	go m.janitor(interval)
 	return nil
 }
 
+// janitor removes the expired entries every interval until m is closed
+func (m *memoryBackend) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			for _, s := range m.shards {
+				s.deleteExpired(now)
+			}
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func (m *memoryBackend) shard(key string) *shard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return m.shards[h.Sum32()%uint32(len(m.shards))]
+}
+
 func (m *memoryBackend) CheckHealth(ctx context.Context) error {
 	return nil
 }
 
 func (m *memoryBackend) Get(ctx context.Context, key string) ([]byte, error) {
-	res, exists := m.client[key]
-	if !exists {
-		return nil, nil
-	}
-	if res.ExpiredAt.Before(time.Now()) {
-		m.Delete(ctx, key)
-		return nil, nil
-	} else {
-		return res.Value, nil
-	}
+	return m.shard(key).lookup(key, time.Now()), nil
 }
 
 func (m *memoryBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	node := &memoryBackendNode{Value: value, ExpiredAt: time.Now().Add(ttl)}
-	m.client[key] = node
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(key, value, time.Now().Add(ttl))
 	return nil
 }
 
@@ -74,10 +147,11 @@ func (m *memoryBackend) GetMany(ctx context.Context, keys []string) [][]byte {
 }
 
 func (m *memoryBackend) Delete(ctx context.Context, key string) bool {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	if _, ok := m.client[key]; ok {
-		delete(m.client, key)
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[key]; ok {
+		s.remove(e)
 		return true
 	}
 	return false
@@ -94,34 +168,97 @@ func (m *memoryBackend) DeleteMany(ctx context.Context, keys []string) bool {
 }
 
 func (m *memoryBackend) Expire(ctx context.Context, key string, ttl time.Duration) bool {
-	val, _ := m.Get(ctx, key)
-	if val == nil {
-		return false
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if e := s.get(key, now); e != nil {
+		e.expiredAt = now.Add(ttl)
+		return true
 	}
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	m.client[key].ExpiredAt = time.Now().Add(ttl)
-	return true
+	return false
 }
 
 func (m *memoryBackend) TTL(ctx context.Context, key string) time.Duration {
-	_, _ = m.Get(ctx, key)
-	val := m.client[key]
-	if val == nil {
-		return 0
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if e := s.get(key, now); e != nil {
+		return e.expiredAt.Sub(now)
 	}
-	return time.Until(val.ExpiredAt)
+	return 0
 }
 
 func (m *memoryBackend) Exists(ctx context.Context, key string) bool {
-	val, _ := m.Get(ctx, key)
-	if val == nil {
-		return false
-	} else {
-		return true
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.get(key, time.Now()) != nil
+}
+
+// DeletePrefix implements cachext.PrefixDeleter
+func (m *memoryBackend) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleted := 0
+	for _, s := range m.shards {
+		deleted += s.deletePrefix(prefix)
+	}
+	return deleted, nil
+}
+
+// Lock implements cachext.Locker
+func (m *memoryBackend) Lock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.get(key, now) != nil {
+		return false, nil
+	}
+	s.set(key, []byte(token), now.Add(ttl))
+	return true, nil
+}
+
+// Unlock implements cachext.Locker
+func (m *memoryBackend) Unlock(ctx context.Context, key, token string) error {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[key]; ok && string(e.value) == token {
+		s.remove(e)
+	}
+	return nil
+}
+
+// Stats implements cachext.StatsReporter
+func (m *memoryBackend) Stats() cachext.BackendStats {
+	var stats cachext.BackendStats
+	for _, s := range m.shards {
+		s.mu.Lock()
+		stats.Entries += int64(len(s.entries))
+		stats.Bytes += s.bytes
+		stats.Hits += s.hits
+		stats.Misses += s.misses
+		stats.Evictions += s.evictions
+		stats.Expirations += s.expirations
+		s.mu.Unlock()
 	}
+	return stats
 }
 
 func (m *memoryBackend) Close() error {
+	m.closeOnce.Do(func() {
+		if m.stop != nil {
+			close(m.stop)
+		}
+	})
 	return nil
 }
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
+
+func ceilDiv64(a, b int64) int64 {
+	return (a + b - 1) / b
+}
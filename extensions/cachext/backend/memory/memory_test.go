@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/shanbay/gobay/extensions/cachext"
+)
+
+func newBackend(t *testing.T, settings map[string]interface{}) *memoryBackend {
+	config := viper.New()
+	for k, v := range settings {
+		config.Set(k, v)
+	}
+	m := &memoryBackend{}
+	assert.Nil(t, m.Init(config))
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+func TestMemoryBackend_LRU(t *testing.T) {
+	ctx := context.Background()
+	m := newBackend(t, map[string]interface{}{"memory_max_entries": 2, "memory_shards": 1})
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Set(ctx, "b", []byte("2"), time.Minute)
+	// a is used recently, b is evicted
+	val, _ := m.Get(ctx, "a")
+	assert.Equal(t, []byte("1"), val)
+	m.Set(ctx, "c", []byte("3"), time.Minute)
+	assert.False(t, m.Exists(ctx, "b"))
+	assert.True(t, m.Exists(ctx, "a"))
+	assert.True(t, m.Exists(ctx, "c"))
+	assert.Equal(t, int64(1), m.Stats().Evictions)
+}
+
+func TestMemoryBackend_LFU(t *testing.T) {
+	ctx := context.Background()
+	m := newBackend(t, map[string]interface{}{
+		"memory_max_entries": 2, "memory_shards": 1, "memory_eviction": "lfu"})
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Set(ctx, "b", []byte("2"), time.Minute)
+	// a is used more frequently though b is used recently, b is evicted
+	m.Get(ctx, "a")
+	m.Get(ctx, "a")
+	m.Get(ctx, "b")
+	m.Set(ctx, "c", []byte("3"), time.Minute)
+	assert.False(t, m.Exists(ctx, "b"))
+	assert.True(t, m.Exists(ctx, "a"))
+	// c is the least frequently used now
+	m.Set(ctx, "d", []byte("4"), time.Minute)
+	assert.False(t, m.Exists(ctx, "c"))
+	assert.True(t, m.Exists(ctx, "a"))
+
+	config := viper.New()
+	config.Set("memory_eviction", "fifo")
+	assert.NotNil(t, (&memoryBackend{}).Init(config))
+}
+
+func TestMemoryBackend_MaxBytes(t *testing.T) {
+	ctx := context.Background()
+	m := newBackend(t, map[string]interface{}{"memory_max_bytes": 10, "memory_shards": 1})
+	m.Set(ctx, "a", []byte("1234"), time.Minute)
+	m.Set(ctx, "b", []byte("1234"), time.Minute)
+	assert.Equal(t, int64(10), m.Stats().Bytes)
+	m.Set(ctx, "c", []byte("1"), time.Minute)
+	assert.False(t, m.Exists(ctx, "a"))
+	// a value larger than the bound is not kept
+	m.Set(ctx, "d", make([]byte, 20), time.Minute)
+	assert.False(t, m.Exists(ctx, "d"))
+	stats := m.Stats()
+	assert.Equal(t, int64(2), stats.Entries)
+	assert.Equal(t, int64(7), stats.Bytes)
+	assert.Equal(t, int64(1), stats.Evictions)
+}
+
+func TestMemoryBackend_Janitor(t *testing.T) {
+	ctx := context.Background()
+	m := newBackend(t, map[string]interface{}{"memory_cleanup_interval": "10ms"})
+	m.Set(ctx, "a", []byte("1"), 5*time.Millisecond)
+	m.Set(ctx, "b", []byte("2"), time.Minute)
+	assert.Eventually(t, func() bool {
+		return m.Stats().Expirations == 1
+	}, time.Second, 5*time.Millisecond)
+	stats := m.Stats()
+	assert.Equal(t, int64(1), stats.Entries)
+	assert.Equal(t, int64(2), stats.Bytes)
+
+	assert.Nil(t, m.Close())
+	assert.Nil(t, m.Close())
+}
+
+func TestMemoryBackend_Stats(t *testing.T) {
+	ctx := context.Background()
+	m := newBackend(t, nil)
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Get(ctx, "a")
+	m.Get(ctx, "b")
+	assert.Equal(t, time.Duration(0), m.TTL(ctx, "b"))
+	assert.True(t, m.Expire(ctx, "a", time.Millisecond))
+	time.Sleep(2 * time.Millisecond)
+	assert.False(t, m.Exists(ctx, "a"))
+	assert.Equal(t, cachext.BackendStats{Hits: 1, Misses: 1, Expirations: 1}, m.Stats())
+}
+
+func TestMemoryBackend_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	m := newBackend(t, map[string]interface{}{"memory_max_entries": 100, "memory_shards": 4})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := strconv.Itoa((i * j) % 300)
+				m.Set(ctx, key, []byte(key), time.Minute)
+				m.Get(ctx, key)
+				m.TTL(ctx, key)
+				m.Delete(ctx, strconv.Itoa(j%300))
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, m.Stats().Entries, int64(100))
+}
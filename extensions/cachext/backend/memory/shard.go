@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"container/heap"
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// entry is a cached value, its size is the length of the key and value
+type entry struct {
+	key       string
+	value     []byte
+	expiredAt time.Time
+	size      int64
+
+	// for lru
+	elem *list.Element
+	// for lfu
+	freq  int64
+	seq   int64
+	index int
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return e.expiredAt.Before(now)
+}
+
+// policy chooses the entry to evict when a shard is full
+type policy interface {
+	add(e *entry)
+	access(e *entry)
+	remove(e *entry)
+	victim() *entry
+}
+
+func newPolicy(eviction string) policy {
+	if eviction == evictionLFU {
+		return &lfuPolicy{}
+	}
+	return &lruPolicy{ll: list.New()}
+}
+
+// lruPolicy evicts the least recently used entry
+type lruPolicy struct {
+	ll *list.List
+}
+
+func (p *lruPolicy) add(e *entry)    { e.elem = p.ll.PushFront(e) }
+func (p *lruPolicy) access(e *entry) { p.ll.MoveToFront(e.elem) }
+func (p *lruPolicy) remove(e *entry) { p.ll.Remove(e.elem) }
+
+func (p *lruPolicy) victim() *entry {
+	if back := p.ll.Back(); back != nil {
+		return back.Value.(*entry)
+	}
+	return nil
+}
+
+// lfuPolicy evicts the least frequently used entry, or the least recently
+// used one of them. It is a min heap of the entries by frequency.
+type lfuPolicy struct {
+	entries []*entry
+	seq     int64
+}
+
+func (p *lfuPolicy) Len() int { return len(p.entries) }
+
+func (p *lfuPolicy) Less(i, j int) bool {
+	a, b := p.entries[i], p.entries[j]
+	if a.freq != b.freq {
+		return a.freq < b.freq
+	}
+	return a.seq < b.seq
+}
+
+func (p *lfuPolicy) Swap(i, j int) {
+	p.entries[i], p.entries[j] = p.entries[j], p.entries[i]
+	p.entries[i].index = i
+	p.entries[j].index = j
+}
+
+func (p *lfuPolicy) Push(x interface{}) {
+	e := x.(*entry)
+	e.index = len(p.entries)
+	p.entries = append(p.entries, e)
+}
+
+func (p *lfuPolicy) Pop() interface{} {
+	n := len(p.entries)
+	e := p.entries[n-1]
+	p.entries[n-1] = nil
+	p.entries = p.entries[:n-1]
+	return e
+}
+
+func (p *lfuPolicy) add(e *entry) {
+	p.seq++
+	e.freq, e.seq = 1, p.seq
+	heap.Push(p, e)
+}
+
+func (p *lfuPolicy) access(e *entry) {
+	p.seq++
+	e.freq++
+	e.seq = p.seq
+	heap.Fix(p, e.index)
+}
+
+func (p *lfuPolicy) remove(e *entry) { heap.Remove(p, e.index) }
+
+func (p *lfuPolicy) victim() *entry {
+	if len(p.entries) == 0 {
+		return nil
+	}
+	return p.entries[0]
+}
+
+// shard is a part of the entries with its own lock, bounded by maxEntries
+// and maxBytes if they are positive
+type shard struct {
+	mu         sync.Mutex
+	entries    map[string]*entry
+	policy     policy
+	maxEntries int
+	maxBytes   int64
+	bytes      int64
+
+	hits        int64
+	misses      int64
+	evictions   int64
+	expirations int64
+}
+
+func newShard(eviction string, maxEntries int, maxBytes int64) *shard {
+	return &shard{
+		entries:    make(map[string]*entry),
+		policy:     newPolicy(eviction),
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+	}
+}
+
+// get returns the entry of key if it is not expired, the caller must hold
+// the lock. It does not count the hit or miss, see lookup.
+func (s *shard) get(key string, now time.Time) *entry {
+	e, ok := s.entries[key]
+	if !ok {
+		return nil
+	}
+	if e.expired(now) {
+		s.remove(e)
+		s.expirations++
+		return nil
+	}
+	return e
+}
+
+// lookup returns the value of key and counts the hit or miss
+func (s *shard) lookup(key string, now time.Time) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.get(key, now)
+	if e == nil {
+		s.misses++
+		return nil
+	}
+	s.hits++
+	s.policy.access(e)
+	return e.value
+}
+
+// set sets key to value and evicts entries before adding it if the shard
+// is full, the caller must hold the lock. A value larger than maxBytes is
+// not kept.
+func (s *shard) set(key string, value []byte, expiredAt time.Time) {
+	if e, ok := s.entries[key]; ok {
+		s.remove(e)
+	}
+	e := &entry{key: key, value: value, expiredAt: expiredAt, size: int64(len(key) + len(value))}
+	if s.maxBytes > 0 && e.size > s.maxBytes {
+		return
+	}
+	for s.full(e.size) {
+		victim := s.policy.victim()
+		if victim == nil {
+			break
+		}
+		s.remove(victim)
+		s.evictions++
+	}
+	s.entries[key] = e
+	s.bytes += e.size
+	s.policy.add(e)
+}
+
+// full returns whether there is no room for an entry of size
+func (s *shard) full(size int64) bool {
+	return (s.maxEntries > 0 && len(s.entries) >= s.maxEntries) ||
+		(s.maxBytes > 0 && s.bytes+size > s.maxBytes)
+}
+
+// remove removes the entry, the caller must hold the lock
+func (s *shard) remove(e *entry) {
+	s.policy.remove(e)
+	delete(s.entries, e.key)
+	s.bytes -= e.size
+}
+
+// deletePrefix removes the entries whose keys start with prefix, and
+// returns the number of them
+func (s *shard) deletePrefix(prefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deleted := 0
+	for key, e := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			s.remove(e)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// deleteExpired removes the expired entries
+func (s *shard) deleteExpired(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if e.expired(now) {
+			s.remove(e)
+			s.expirations++
+		}
+	}
+}
@@ -22,6 +22,21 @@ type redisBackend struct {
 	client *redis.Client
 }
 
+var (
+	_ cachext.Locker        = (*redisBackend)(nil)
+	_ cachext.PrefixDeleter = (*redisBackend)(nil)
+)
+
+// number of keys scanned at a time by DeletePrefix
+const scanCount = 1000
+
+// unlockScript deletes the lock only if it is still held by the token
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
 func (b *redisBackend) withContext(ctx context.Context) *redis.Client {
 	if observability.GetApmEnable() {
 		return apmgoredis.Wrap(b.client).WithContext(ctx).RedisClient()
@@ -119,6 +134,42 @@ func (b *redisBackend) Exists(ctx context.Context, key string) bool {
 	return res.Val() == 1
 }
 
+// Lock implements cachext.Locker
+func (b *redisBackend) Lock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	client := b.withContext(ctx)
+	return client.SetNX(key, token, ttl).Result()
+}
+
+// Unlock implements cachext.Locker
+func (b *redisBackend) Unlock(ctx context.Context, key, token string) error {
+	client := b.withContext(ctx)
+	return unlockScript.Run(client, []string{key}, token).Err()
+}
+
+// DeletePrefix implements cachext.PrefixDeleter by SCAN and DEL
+func (b *redisBackend) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	client := b.withContext(ctx)
+	var cursor uint64
+	deleted := 0
+	for {
+		keys, next, err := client.Scan(cursor, cachext.ScanPattern(prefix), scanCount).Result()
+		if err != nil {
+			return deleted, err
+		}
+		if len(keys) > 0 {
+			n, err := client.Del(keys...).Result()
+			if err != nil {
+				return deleted, err
+			}
+			deleted += int(n)
+		}
+		if next == 0 {
+			return deleted, nil
+		}
+		cursor = next
+	}
+}
+
 func (b *redisBackend) Close() error {
 	return b.client.Close()
 }
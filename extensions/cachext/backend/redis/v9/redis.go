@@ -23,6 +23,21 @@ type redisBackend struct {
 	client *redis.Client
 }
 
+var (
+	_ cachext.Locker        = (*redisBackend)(nil)
+	_ cachext.PrefixDeleter = (*redisBackend)(nil)
+)
+
+// number of keys scanned at a time by DeletePrefix
+const scanCount = 1000
+
+// unlockScript deletes the lock only if it is still held by the token
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
 func (b *redisBackend) Init(config *viper.Viper) error {
 	host := config.GetString("host")
 	password := config.GetString("password")
@@ -110,6 +125,44 @@ func (b *redisBackend) Exists(ctx context.Context, key string) bool {
 	return res.Val() == 1
 }
 
+// Lock implements cachext.Locker
+func (b *redisBackend) Lock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	return b.client.SetNX(ctx, key, token, ttl).Result()
+}
+
+// Unlock implements cachext.Locker
+func (b *redisBackend) Unlock(ctx context.Context, key, token string) error {
+	return unlockScript.Run(ctx, b.client, []string{key}, token).Err()
+}
+
+// DeletePrefix implements cachext.PrefixDeleter by SCAN and DEL
+func (b *redisBackend) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleted := 0
+	iter := b.client.Scan(ctx, 0, cachext.ScanPattern(prefix), scanCount).Iterator()
+	keys := make([]string, 0, scanCount)
+	del := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		n, err := b.client.Del(ctx, keys...).Result()
+		deleted += int(n)
+		keys = keys[:0]
+		return err
+	}
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == scanCount {
+			if err := del(); err != nil {
+				return deleted, err
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return deleted, err
+	}
+	return deleted, del()
+}
+
 func (b *redisBackend) Close() error {
 	return b.client.Close()
 }
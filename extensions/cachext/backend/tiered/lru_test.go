@@ -0,0 +1,41 @@
+package tiered
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+	l := newLRU(2)
+	l.Set("a", []byte("1"), time.Minute)
+	l.Set("b", []byte("2"), time.Minute)
+	// a is used recently, b is evicted
+	_, ok := l.Get("a")
+	assert.True(t, ok)
+	l.Set("c", []byte("3"), time.Minute)
+	assert.Equal(t, 2, l.Len())
+	_, ok = l.Get("b")
+	assert.False(t, ok)
+
+	l.Set("a", []byte("4"), time.Minute)
+	val, ok := l.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("4"), val)
+
+	l.Delete("a", "x")
+	_, ok = l.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, l.Len())
+
+	l.Set("d", []byte("5"), 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	_, ok = l.Get("d")
+	assert.False(t, ok)
+
+	l.Purge()
+	assert.Equal(t, 0, l.Len())
+	_, ok = l.Get("c")
+	assert.False(t, ok)
+}
@@ -0,0 +1,384 @@
+package tiered
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
+	uuid "github.com/satori/go.uuid"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/shanbay/gobay"
+	"github.com/shanbay/gobay/extensions/cachext"
+	"github.com/shanbay/gobay/observability"
+)
+
+const (
+	// number of keys scanned at a time by DeletePrefix
+	scanCount = 1000
+
+	defaultLocalSize           = 10000
+	defaultLocalTTL            = time.Second
+	defaultInvalidationChannel = "gobay_cache_invalidation"
+
+	// PTTL of a key which does not exist, e.g. it expired after GET
+	keyMissing = -2
+)
+
+func init() {
+	if err := cachext.RegisterBackend("tiered", func() cachext.CacheBackend { return &tieredBackend{} }); err != nil {
+		panic("TieredBackend init error")
+	}
+}
+
+// tieredBackend caches the values of redis in a local LRU for a short
+// time. The changes of keys are published to the invalidation channel, and
+// every process evicts the changed keys from its LRU when it receives them.
+type tieredBackend struct {
+	client   *redis.Client
+	local    *lru
+	localTTL time.Duration
+	channel  string
+	nodeID   string
+	pubsub   *redis.PubSub
+	// generation is increased by every invalidation received, values read
+	// from redis are not cached locally if it is changed during reading
+	generation atomic.Uint64
+	logger     *slog.Logger
+	localHit   metric.Int64Counter
+	localMiss  metric.Int64Counter
+	attrs      metric.MeasurementOption
+}
+
+// Config the config of tiered backend, keys are prefixed with the NS of
+// CacheExt
+type Config struct {
+	LocalSize           int           `mapstructure:"local_size" default:"10000"`
+	LocalTTL            time.Duration `mapstructure:"local_ttl" default:"1s"`
+	InvalidationChannel string        `mapstructure:"invalidation_channel" default:"gobay_cache_invalidation"`
+}
+
+// invalidation is published to the invalidation channel
+type invalidation struct {
+	Node string   `json:"node"`
+	Keys []string `json:"keys"`
+}
+
+var (
+	_ cachext.Locker              = (*tieredBackend)(nil)
+	_ cachext.MeterSetter         = (*tieredBackend)(nil)
+	_ cachext.ConfigurableBackend = (*tieredBackend)(nil)
+	_ cachext.PrefixDeleter       = (*tieredBackend)(nil)
+	_ gobay.LoggerSetter          = (*tieredBackend)(nil)
+)
+
+// unlockScript deletes the lock only if it is still held by the token
+var unlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
+// ConfigSchema implements cachext.ConfigurableBackend
+func (b *tieredBackend) ConfigSchema() interface{} {
+	return &Config{}
+}
+
+// SetLogger implements gobay.LoggerSetter
+func (b *tieredBackend) SetLogger(logger *slog.Logger) {
+	b.logger = logger
+}
+
+// SetMeter implements cachext.MeterSetter, the local hits and misses are
+// recorded in <namespace>_local_hit_counter and <namespace>_local_miss_counter
+func (b *tieredBackend) SetMeter(meter metric.Meter, namespace string) error {
+	var err error
+	if b.localHit, err = meter.Int64Counter(namespace+"_local_hit_counter",
+		metric.WithDescription("Number of hits of the local cache")); err != nil {
+		return err
+	}
+	if b.localMiss, err = meter.Int64Counter(namespace+"_local_miss_counter",
+		metric.WithDescription("Number of misses of the local cache")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b *tieredBackend) Init(config *viper.Viper) error {
+	if b.logger == nil {
+		b.logger = slog.Default()
+	}
+	b.attrs = metric.WithAttributes(attribute.String("prefix_name", config.GetString("prefix")))
+	size := config.GetInt("local_size")
+	if size <= 0 {
+		size = defaultLocalSize
+	}
+	b.local = newLRU(size)
+	b.localTTL = config.GetDuration("local_ttl")
+	if b.localTTL <= 0 {
+		b.localTTL = defaultLocalTTL
+	}
+	b.channel = config.GetString("invalidation_channel")
+	if b.channel == "" {
+		b.channel = defaultInvalidationChannel
+	}
+	b.nodeID = uuid.NewV4().String()
+
+	b.client = redis.NewClient(&redis.Options{
+		Addr:     config.GetString("host"),
+		Password: config.GetString("password"),
+		DB:       config.GetInt("db"),
+	})
+	if observability.GetOtelEnable() {
+		tp := otel.GetTracerProvider()
+		if err := redisotel.InstrumentTracing(b.client, redisotel.WithTracerProvider(tp)); err != nil {
+			return err
+		}
+	}
+	ctx := context.Background()
+	if err := b.client.Ping(ctx).Err(); err != nil {
+		return err
+	}
+	b.pubsub = b.client.Subscribe(ctx, b.channel)
+	// wait for the subscription to be confirmed
+	if _, err := b.pubsub.Receive(ctx); err != nil {
+		b.pubsub.Close()
+		return err
+	}
+	go b.listen(b.pubsub.Channel())
+	return nil
+}
+
+// listen evicts the keys changed by other processes until the subscription
+// is closed
+func (b *tieredBackend) listen(ch <-chan *redis.Message) {
+	for msg := range ch {
+		var inv invalidation
+		if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil {
+			b.logger.Warn("invalid cache invalidation, purge the local cache", "error", err)
+			b.generation.Add(1)
+			b.local.Purge()
+			continue
+		}
+		if inv.Node == b.nodeID {
+			continue
+		}
+		b.generation.Add(1)
+		b.local.Delete(inv.Keys...)
+	}
+}
+
+// invalidate publishes the changed keys to other processes. Failures are
+// only logged, the stale values expire after the local ttl.
+func (b *tieredBackend) invalidate(ctx context.Context, keys ...string) {
+	data, err := json.Marshal(invalidation{Node: b.nodeID, Keys: keys})
+	if err == nil {
+		err = b.client.Publish(ctx, b.channel, data).Err()
+	}
+	if err != nil {
+		b.logger.Warn("publish cache invalidation failed", "keys", keys, "error", err)
+	}
+}
+
+// localTTLOf returns the ttl of a value cached locally, which is not longer
+// than its ttl in redis
+func (b *tieredBackend) localTTLOf(ttl time.Duration) time.Duration {
+	if ttl > 0 && ttl < b.localTTL {
+		return ttl
+	}
+	return b.localTTL
+}
+
+func (b *tieredBackend) recordLocal(ctx context.Context, hits, misses int) {
+	if b.localHit != nil && hits > 0 {
+		b.localHit.Add(ctx, int64(hits), b.attrs)
+	}
+	if b.localMiss != nil && misses > 0 {
+		b.localMiss.Add(ctx, int64(misses), b.attrs)
+	}
+}
+
+func (b *tieredBackend) CheckHealth(ctx context.Context) error {
+	return b.client.Ping(ctx).Err()
+}
+
+func (b *tieredBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	if val, ok := b.local.Get(key); ok {
+		b.recordLocal(ctx, 1, 0)
+		return val, nil
+	}
+	b.recordLocal(ctx, 0, 1)
+	generation := b.generation.Load()
+	var get *redis.StringCmd
+	var pttl *redis.DurationCmd
+	// the errors are checked by the commands
+	_, _ = b.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key)
+		pttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+	val, err := get.Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	b.setLocal(key, val, pttl, generation)
+	return val, nil
+}
+
+// setLocal caches the value read from redis locally, not longer than its
+// remaining ttl in redis, unless an invalidation is received while reading
+func (b *tieredBackend) setLocal(key string, value []byte, pttl *redis.DurationCmd, generation uint64) {
+	ttl, err := pttl.Result()
+	if err != nil || ttl == keyMissing || b.generation.Load() != generation {
+		return
+	}
+	b.local.Set(key, value, b.localTTLOf(ttl))
+}
+
+func (b *tieredBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := b.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return err
+	}
+	b.local.Set(key, value, b.localTTLOf(ttl))
+	b.invalidate(ctx, key)
+	return nil
+}
+
+func (b *tieredBackend) SetMany(ctx context.Context, keyValues map[string][]byte, ttl time.Duration) error {
+	keys := make([]string, 0, len(keyValues))
+	if _, err := b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range keyValues {
+			pipe.Set(ctx, key, value, ttl)
+			keys = append(keys, key)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for key, value := range keyValues {
+		b.local.Set(key, value, b.localTTLOf(ttl))
+	}
+	b.invalidate(ctx, keys...)
+	return nil
+}
+
+func (b *tieredBackend) GetMany(ctx context.Context, keys []string) [][]byte {
+	res := make([][]byte, len(keys))
+	var missing []string
+	var missingIdx []int
+	for i, key := range keys {
+		if val, ok := b.local.Get(key); ok {
+			res[i] = val
+		} else {
+			missing = append(missing, key)
+			missingIdx = append(missingIdx, i)
+		}
+	}
+	b.recordLocal(ctx, len(keys)-len(missing), len(missing))
+	if len(missing) == 0 {
+		return res
+	}
+	generation := b.generation.Load()
+	var mget *redis.SliceCmd
+	pttls := make([]*redis.DurationCmd, len(missing))
+	_, _ = b.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		mget = pipe.MGet(ctx, missing...)
+		for i, key := range missing {
+			pttls[i] = pipe.PTTL(ctx, key)
+		}
+		return nil
+	})
+	for i, value := range mget.Val() {
+		if s, ok := value.(string); ok {
+			res[missingIdx[i]] = []byte(s)
+			b.setLocal(missing[i], []byte(s), pttls[i], generation)
+		}
+	}
+	return res
+}
+
+func (b *tieredBackend) Delete(ctx context.Context, key string) bool {
+	return b.DeleteMany(ctx, []string{key})
+}
+
+func (b *tieredBackend) DeleteMany(ctx context.Context, keys []string) bool {
+	n := b.client.Del(ctx, keys...).Val()
+	b.local.Delete(keys...)
+	b.invalidate(ctx, keys...)
+	return n > 0
+}
+
+func (b *tieredBackend) Expire(ctx context.Context, key string, ttl time.Duration) bool {
+	res := b.client.Expire(ctx, key, ttl).Val()
+	b.local.Delete(key)
+	b.invalidate(ctx, key)
+	return res
+}
+
+func (b *tieredBackend) TTL(ctx context.Context, key string) time.Duration {
+	return b.client.TTL(ctx, key).Val()
+}
+
+func (b *tieredBackend) Exists(ctx context.Context, key string) bool {
+	if _, ok := b.local.Get(key); ok {
+		return true
+	}
+	return b.client.Exists(ctx, key).Val() == 1
+}
+
+// Lock implements cachext.Locker
+func (b *tieredBackend) Lock(ctx context.Context, key, token string, ttl time.Duration) (bool, error) {
+	return b.client.SetNX(ctx, key, token, ttl).Result()
+}
+
+// Unlock implements cachext.Locker
+func (b *tieredBackend) Unlock(ctx context.Context, key, token string) error {
+	return unlockScript.Run(ctx, b.client, []string{key}, token).Err()
+}
+
+// DeletePrefix implements cachext.PrefixDeleter by SCAN and DEL, the keys
+// are evicted from the local caches like DeleteMany
+func (b *tieredBackend) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleted := 0
+	iter := b.client.Scan(ctx, 0, cachext.ScanPattern(prefix), scanCount).Iterator()
+	keys := make([]string, 0, scanCount)
+	del := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		n, err := b.client.Del(ctx, keys...).Result()
+		deleted += int(n)
+		b.local.Delete(keys...)
+		b.invalidate(ctx, keys...)
+		keys = keys[:0]
+		return err
+	}
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == scanCount {
+			if err := del(); err != nil {
+				return deleted, err
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return deleted, err
+	}
+	return deleted, del()
+}
+
+func (b *tieredBackend) Close() error {
+	if err := b.pubsub.Close(); err != nil {
+		return err
+	}
+	return b.client.Close()
+}
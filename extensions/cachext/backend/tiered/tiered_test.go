@@ -0,0 +1,166 @@
+package tiered
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	uuid "github.com/satori/go.uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// newBackend returns a backend connected to the redis of testdata, the
+// backends of a test share an invalidation channel of their own
+func newBackend(t *testing.T, channel string, meter *sdkmetric.MeterProvider) *tieredBackend {
+	config := viper.New()
+	config.Set("host", "127.0.0.1:6379")
+	config.Set("prefix", "tiered")
+	config.Set("invalidation_channel", channel)
+	b := &tieredBackend{}
+	if meter != nil {
+		assert.Nil(t, b.SetMeter(meter.Meter("test"), "cache"))
+	}
+	if err := b.Init(config); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+func TestTieredBackend_Invalidation(t *testing.T) {
+	ctx := context.Background()
+	channel := uuid.NewV4().String()
+	a := newBackend(t, channel, nil)
+	b := newBackend(t, channel, nil)
+	a.Delete(ctx, "tiered_key")
+
+	assert.Nil(t, a.Set(ctx, "tiered_key", []byte("1"), time.Minute))
+	val, err := b.Get(ctx, "tiered_key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), val)
+	_, ok := b.local.Get("tiered_key")
+	assert.True(t, ok)
+
+	// the value changed by a is evicted from the local cache of b
+	assert.Nil(t, a.Set(ctx, "tiered_key", []byte("2"), time.Minute))
+	assert.Eventually(t, func() bool {
+		_, ok := b.local.Get("tiered_key")
+		return !ok
+	}, time.Second, time.Millisecond)
+	val, err = b.Get(ctx, "tiered_key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), val)
+
+	// so is the deleted value
+	assert.True(t, a.Delete(ctx, "tiered_key"))
+	assert.Eventually(t, func() bool {
+		_, ok := b.local.Get("tiered_key")
+		return !ok
+	}, time.Second, time.Millisecond)
+	val, err = b.Get(ctx, "tiered_key")
+	assert.Nil(t, err)
+	assert.Nil(t, val)
+
+	// a ignores its own invalidations
+	assert.Nil(t, a.Set(ctx, "tiered_key", []byte("3"), time.Minute))
+	time.Sleep(50 * time.Millisecond)
+	_, ok = a.local.Get("tiered_key")
+	assert.True(t, ok)
+	a.Delete(ctx, "tiered_key")
+}
+
+// invalidateHook simulates an invalidation received while reading redis
+type invalidateHook struct {
+	b *tieredBackend
+}
+
+func (h invalidateHook) DialHook(next redis.DialHook) redis.DialHook { return next }
+
+func (h invalidateHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook { return next }
+
+func (h invalidateHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		err := next(ctx, cmds)
+		h.b.generation.Add(1)
+		return err
+	}
+}
+
+func TestTieredBackend_Generation(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t, uuid.NewV4().String(), nil)
+	assert.Nil(t, b.client.Set(ctx, "tiered_key", "1", time.Minute).Err())
+	assert.Nil(t, b.client.Set(ctx, "tiered_key2", "2", time.Minute).Err())
+	defer b.DeleteMany(ctx, []string{"tiered_key", "tiered_key2"})
+
+	b.client.AddHook(invalidateHook{b})
+	// the values may be stale, they are returned but not cached
+	val, err := b.Get(ctx, "tiered_key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), val)
+	_, ok := b.local.Get("tiered_key")
+	assert.False(t, ok)
+
+	vals := b.GetMany(ctx, []string{"tiered_key", "tiered_key2"})
+	assert.Equal(t, [][]byte{[]byte("1"), []byte("2")}, vals)
+	assert.Equal(t, 0, b.local.Len())
+}
+
+func TestTieredBackend_LocalTTL(t *testing.T) {
+	ctx := context.Background()
+	b := newBackend(t, uuid.NewV4().String(), nil)
+	assert.Nil(t, b.client.Set(ctx, "tiered_key", "1", 100*time.Millisecond).Err())
+	assert.Nil(t, b.client.Set(ctx, "tiered_key2", "2", 100*time.Millisecond).Err())
+	assert.Nil(t, b.client.Set(ctx, "tiered_key3", "3", time.Minute).Err())
+	defer b.DeleteMany(ctx, []string{"tiered_key3"})
+
+	// the values are not cached locally after they expire in redis
+	_, err := b.Get(ctx, "tiered_key")
+	assert.Nil(t, err)
+	b.GetMany(ctx, []string{"tiered_key2", "tiered_key3"})
+	assert.Equal(t, 3, b.local.Len())
+	time.Sleep(200 * time.Millisecond)
+	_, ok := b.local.Get("tiered_key")
+	assert.False(t, ok)
+	_, ok = b.local.Get("tiered_key2")
+	assert.False(t, ok)
+	_, ok = b.local.Get("tiered_key3")
+	assert.True(t, ok)
+}
+
+func TestTieredBackend_LocalCounters(t *testing.T) {
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	b := newBackend(t, uuid.NewV4().String(), provider)
+	b.DeleteMany(ctx, []string{"tiered_key", "tiered_key2"})
+
+	_, err := b.Get(ctx, "tiered_key") // miss
+	assert.Nil(t, err)
+	assert.Nil(t, b.Set(ctx, "tiered_key", []byte("1"), time.Minute))
+	_, err = b.Get(ctx, "tiered_key") // hit
+	assert.Nil(t, err)
+	b.GetMany(ctx, []string{"tiered_key", "tiered_key2"}) // hit and miss
+	b.Delete(ctx, "tiered_key")
+
+	var rm metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(ctx, &rm))
+	counters := make(map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				prefix, _ := dp.Attributes.Value("prefix_name")
+				assert.Equal(t, "tiered", prefix.AsString())
+				counters[m.Name] += dp.Value
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"cache_local_hit_counter":  2,
+		"cache_local_miss_counter": 2,
+	}, counters)
+}
@@ -3,14 +3,28 @@ package cachext
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/RichardKnop/machinery/v1/log"
-	"github.com/prometheus/client_golang/prometheus"
+	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	lockKeySuffix  = "&lock"
+	staleKeySuffix = "&stale"
+	// interval of checking whether the result is cached by the process
+	// holding the lock
+	lockPollInterval = 20 * time.Millisecond
+
+	// how the calls are coalesced
+	coalescedBy        = "by"
+	coalescedByProcess = "process" // wait for the call in the process
+	coalescedByLock    = "lock"    // wait for the process holding the lock
+	coalescedByStale   = "stale"   // serve the stale result
 )
 
 // CachedConfig save the param and config for a cached func
@@ -21,6 +35,13 @@ type CachedConfig struct {
 	funcName     string
 	makeCacheKey makeCacheKeyFunc
 	getResult    cachedFunc
+	lockTTL      time.Duration
+	lockWait     time.Duration
+	staleTTL     time.Duration
+	negativeTTL  time.Duration
+	isNotFound   func(error) bool
+	notFound     error
+	encoder      encoder
 }
 
 type cacheOption func(config *CachedConfig) error
@@ -49,39 +70,180 @@ func (c *CachedConfig) MakeCacheKey(strArgs []string, intArgs []int64) string {
 }
 
 // GetResult
-func (c *CachedConfig) GetResult(ctx context.Context, out interface{}, strArgs []string, intArgs []int64) error {
+func (c *CachedConfig) GetResult(ctx context.Context, out interface{}, strArgs []string, intArgs []int64) (err error) {
+	ctx, span := c.cache.startSpan(ctx, "cache.cached", attribute.String(attrFunc, c.funcName))
+	defer func() { span.End(err) }()
 	cacheKey := c.MakeCacheKey(strArgs, intArgs)
 	data, err := c.cache.backend.Get(ctx, c.cache.transKey(cacheKey))
 	if err != nil {
 		return err
 	}
-	labels := prometheus.Labels{prefixName: c.cache.prefix, funcName: c.funcName}
+	span.SetAttributes(attribute.Bool(attrHit, data != nil))
+	attrs := metric.WithAttributes(
+		attribute.String(prefixName, c.cache.prefix),
+		attribute.String(funcName, c.funcName),
+	)
 	if c.cache.requestCounter != nil {
 		// Increment request counter.
-		c.cache.requestCounter.With(labels).Inc()
+		c.cache.requestCounter.Add(ctx, 1, attrs)
 	}
 	if data != nil {
 		if c.cache.hitCounter != nil {
 			// Increment hit counter.
-			c.cache.hitCounter.With(labels).Inc()
+			c.cache.hitCounter.Add(ctx, 1, attrs)
 		}
-		return decode(data, out)
+		span.SetAttributes(attribute.Int(attrPayloadSize, len(data)))
+		return c.decode(ctx, data, out)
 	}
-	res, err := c.getResult(ctx, strArgs, intArgs)
+	encodedBytes, err := c.load(ctx, c.cache.transKey(cacheKey), strArgs, intArgs)
 	if err != nil {
 		return err
 	}
+	span.SetAttributes(attribute.Int(attrPayloadSize, len(encodedBytes)))
+	return c.decode(ctx, encodedBytes, out)
+}
+
+// load returns the encoded result of the cached function, concurrent loads
+// of the same key in the process are coalesced into one. With a lock, only
+// the process holding the lock calls the function, the others wait for the
+// result or serve the stale result.
+//
+// The shared call is not canceled with the ctx of the caller starting it,
+// otherwise the other callers would fail with its ctx.Err(). Each caller
+// stops waiting when its own ctx is done.
+func (c *CachedConfig) load(ctx context.Context, key string, strArgs []string, intArgs []int64) ([]byte, error) {
+	called := false
+	sharedCtx := context.WithoutCancel(ctx)
+	ch := c.cache.group.DoChan(key, func() (interface{}, error) {
+		called = true
+		if c.lockTTL > 0 {
+			return c.loadWithLock(sharedCtx, key, strArgs, intArgs)
+		}
+		return c.callAndSet(sharedCtx, key, strArgs, intArgs)
+	})
+	select {
+	case res := <-ch:
+		if !called {
+			c.recordCoalesced(ctx, coalescedByProcess)
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *CachedConfig) loadWithLock(ctx context.Context, key string, strArgs []string, intArgs []int64) ([]byte, error) {
+	locker, ok := c.cache.backend.(Locker)
+	if !ok {
+		return c.callAndSet(ctx, key, strArgs, intArgs)
+	}
+	lockKey, token := key+lockKeySuffix, uuid.NewV4().String()
+	locked, err := locker.Lock(ctx, lockKey, token, c.lockTTL)
+	if err != nil {
+		c.cache.logger.Warn("lock cached func failed", "func", c.funcName, "error", err)
+		return c.callAndSet(ctx, key, strArgs, intArgs)
+	}
+	if locked {
+		defer func() {
+			if err := locker.Unlock(ctx, lockKey, token); err != nil {
+				c.cache.logger.Warn("unlock cached func failed", "func", c.funcName, "error", err)
+			}
+		}()
+		return c.callAndSet(ctx, key, strArgs, intArgs)
+	}
+
+	if c.staleTTL > 0 {
+		if data, err := c.cache.backend.Get(ctx, key+staleKeySuffix); err == nil && data != nil {
+			c.recordCoalesced(ctx, coalescedByStale)
+			return data, nil
+		}
+	}
+	timer := time.NewTimer(c.lockWait)
+	defer timer.Stop()
+	ticker := time.NewTicker(lockPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if data, err := c.cache.backend.Get(ctx, key); err == nil && data != nil {
+				c.recordCoalesced(ctx, coalescedByLock)
+				return data, nil
+			}
+		case <-timer.C:
+			// the process holding the lock is too slow
+			return c.callAndSet(ctx, key, strArgs, intArgs)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// callAndSet calls the cached function and caches the encoded result
+func (c *CachedConfig) callAndSet(ctx context.Context, key string, strArgs []string, intArgs []int64) ([]byte, error) {
+	res, err := c.call(ctx, strArgs, intArgs)
+	if err != nil {
+		if c.negativeTTL > 0 && c.isNotFound != nil && c.isNotFound(err) {
+			if err := c.cache.backend.Set(ctx, key, errorTombstone(err), c.negativeTTL); err != nil {
+				return nil, err
+			}
+			return nil, &NotFoundError{Msg: err.Error(), err: c.notFound}
+		}
+		return nil, err
+	}
+	if c.negativeTTL > 0 && isNil(res) {
+		tombstone := nilTombstone()
+		if err := c.cache.backend.Set(ctx, key, tombstone, c.negativeTTL); err != nil {
+			return nil, err
+		}
+		return tombstone, nil
+	}
 
 	// 把结果放入缓存
-	if encodedBytes, err := encode(res); err != nil {
-		return err
-	} else {
-		err = c.cache.backend.Set(ctx, c.cache.transKey(cacheKey), encodedBytes, c.ttl)
-		if err != nil {
-			return err
+	encodedBytes, err := c.encoder.encode(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.cache.backend.Set(ctx, key, encodedBytes, c.ttl); err != nil {
+		return nil, err
+	}
+	if c.staleTTL > 0 {
+		if err := c.cache.backend.Set(ctx, key+staleKeySuffix, encodedBytes, c.ttl+c.staleTTL); err != nil {
+			return nil, err
 		}
-		return decode(encodedBytes, out)
 	}
+	return encodedBytes, nil
+}
+
+func (c *CachedConfig) recordCoalesced(ctx context.Context, by string) {
+	if c.cache.coalescedCounter != nil {
+		c.cache.coalescedCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String(prefixName, c.cache.prefix),
+			attribute.String(funcName, c.funcName),
+			attribute.String(coalescedBy, by),
+		))
+	}
+}
+
+// call calls the cached function in a span
+func (c *CachedConfig) call(ctx context.Context, strArgs []string, intArgs []int64) (res interface{}, err error) {
+	ctx, span := c.cache.startSpan(ctx, "cache.call", attribute.String(attrFunc, c.funcName))
+	defer func() { span.End(err) }()
+	return c.getResult(ctx, strArgs, intArgs)
+}
+
+// decode decodes the cached data or tombstone in a span
+func (c *CachedConfig) decode(ctx context.Context, data []byte, out interface{}) (err error) {
+	_, span := c.cache.startSpan(ctx, "cache.decode",
+		attribute.String(attrFunc, c.funcName), attribute.Int(attrPayloadSize, len(data)))
+	defer func() { span.End(err) }()
+	if isTombstone(data) {
+		span.SetAttributes(attribute.Bool(attrNegative, true))
+		return decodeTombstone(data, out, c.notFound)
+	}
+	return decode(data, out)
 }
 
 // Cached return a ptr with two function: MakeCacheKey and GetResult
@@ -89,7 +251,7 @@ func (c *CacheExt) Cached(funcName string, f cachedFunc, options ...cacheOption)
 	mu.Lock()
 	defer mu.Unlock()
 	if _, ok := c.cachedFuncName[funcName]; ok {
-		log.ERROR.Printf(fmt.Sprintf("Cached Func: `%s` already exists!", funcName))
+		c.logger.Error("cached func already exists", "func", funcName)
 	}
 	c.cachedFuncName[funcName] = void{}
 	cacheFuncConf := &CachedConfig{
@@ -99,6 +261,7 @@ func (c *CacheExt) Cached(funcName string, f cachedFunc, options ...cacheOption)
 		getResult:    f,
 		funcName:     funcName,
 		makeCacheKey: defaultMakeCacheKey,
+		encoder:      c.encoder,
 	}
 	for _, option := range options {
 		if err := option(cacheFuncConf); err != nil {
@@ -119,6 +282,48 @@ func WithTTL(ttl time.Duration) cacheOption {
 	}
 }
 
+// WithLock makes only one process call the function for a key at a time,
+// by a lock expiring after ttl in the backend, if the backend implements
+// Locker. The other processes wait up to wait for the result, or serve the
+// stale result if WithStaleTTL is set, and call the function themselves if
+// the result is not cached after wait.
+func WithLock(ttl, wait time.Duration) cacheOption {
+	return func(config *CachedConfig) error {
+		if ttl <= 0 || wait < 0 {
+			return errors.New("lock ttl should be positive and wait should not be negative")
+		}
+		config.lockTTL = ttl
+		config.lockWait = wait
+		return nil
+	}
+}
+
+// WithStaleTTL keeps a stale copy of the result for ttl after it expires,
+// which is served by the processes waiting for the lock, see WithLock.
+func WithStaleTTL(ttl time.Duration) cacheOption {
+	return func(config *CachedConfig) error {
+		if ttl < 0 {
+			return errors.New("stale ttl should be positive duration")
+		}
+		config.staleTTL = ttl
+		return nil
+	}
+}
+
+// WithCodec encodes the results by the codec named name instead of the
+// codec of the CacheExt, e.g. CodecProtobuf for the proto.Message results.
+// The compression of the CacheExt is still used.
+func WithCodec(name string) cacheOption {
+	return func(config *CachedConfig) error {
+		id, err := codecID(name)
+		if err != nil {
+			return err
+		}
+		config.encoder.codec = id
+		return nil
+	}
+}
+
 // WithVersion set version to the cacheFuncConfig object, if you want a function's all cache
 // update immediately, change the version.
 func WithVersion(version int64) cacheOption {
@@ -0,0 +1,251 @@
+package cachext
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/vmihailenco/msgpack"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec serializes the cached values
+type Codec interface {
+	Name() string
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, out interface{}) error
+}
+
+// names of the builtin codecs and compressions
+const (
+	CodecMsgpack  = "msgpack"
+	CodecJSON     = "json"
+	CodecProtobuf = "protobuf"
+	CodecGob      = "gob"
+
+	CompressionNone   = "none"
+	CompressionZstd   = "zstd"
+	CompressionSnappy = "snappy"
+)
+
+// The encoded values start with a header of two bytes, 0xc1 which is never
+// used by msgpack, and a byte of the codec and compression of the value:
+//
+//	1 0 c c i i i i
+//
+// cc is the compression, and iiii is the id of the codec. The values encoded
+// by msgpack without compression have no header, as they were cached before
+// the codecs were added, so that they can be read by the old versions.
+// Values are always decoded by their header, so changing the codec or
+// compression does not need flushing the cache.
+const (
+	headerMark            = tombstoneMark
+	headerFlag            = 0x80
+	headerCodecMask       = 0x0f
+	headerCompressionMask = 0x30
+	headerCompressionBit  = 4
+
+	compressionNone   = 0
+	compressionZstd   = 1
+	compressionSnappy = 2
+
+	codecIDMsgpack  = 1
+	codecIDJSON     = 2
+	codecIDProtobuf = 3
+	codecIDGob      = 4
+)
+
+var (
+	codecs   = map[string]byte{}
+	codecIDs = map[byte]Codec{}
+
+	compressions = map[string]byte{
+		"":                compressionNone,
+		CompressionNone:   compressionNone,
+		CompressionZstd:   compressionZstd,
+		CompressionSnappy: compressionSnappy,
+	}
+
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+func init() {
+	builtins := map[byte]Codec{
+		codecIDMsgpack:  msgpackCodec{},
+		codecIDJSON:     jsonCodec{},
+		codecIDProtobuf: protobufCodec{},
+		codecIDGob:      gobCodec{},
+	}
+	for id, codec := range builtins {
+		if err := RegisterCodec(id, codec); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterCodec registers a codec with an id from 1 to 15, which is saved
+// in the header of the values. The builtin codecs use 1 to 4, and the id of
+// a codec must not be changed once it is used. It should be called in init,
+// like RegisterBackend.
+func RegisterCodec(id byte, codec Codec) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if id == 0 || id > headerCodecMask {
+		return fmt.Errorf("codec id should be from 1 to %d", headerCodecMask)
+	}
+	if _, exist := codecIDs[id]; exist {
+		return fmt.Errorf("codec id %d already registered", id)
+	}
+	if _, exist := codecs[codec.Name()]; exist {
+		return errors.New("codec already registered: " + codec.Name())
+	}
+	codecs[codec.Name()] = id
+	codecIDs[id] = codec
+	return nil
+}
+
+// codecID returns the id of the registered codec named name, the caller
+// must hold mu
+func codecID(name string) (byte, error) {
+	id, ok := codecs[name]
+	if !ok {
+		return 0, errors.New("no codec found: " + name)
+	}
+	return id, nil
+}
+
+// encoder encodes the values by a codec, and compresses them if they are
+// not smaller than threshold. The zero value encodes by msgpack without
+// compression.
+type encoder struct {
+	codec       byte
+	compression byte
+	threshold   int
+}
+
+func newEncoder(codec, compression string, threshold int) (encoder, error) {
+	id, err := codecID(codec)
+	if err != nil {
+		return encoder{}, err
+	}
+	c, ok := compressions[compression]
+	if !ok {
+		return encoder{}, errors.New("no compression found: " + compression)
+	}
+	return encoder{codec: id, compression: c, threshold: threshold}, nil
+}
+
+func (e encoder) encode(value interface{}) ([]byte, error) {
+	if e.codec == 0 {
+		e.codec = codecIDMsgpack
+	}
+	data, err := codecIDs[e.codec].Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	compression := byte(compressionNone)
+	if e.compression != compressionNone && len(data) >= e.threshold {
+		compression = e.compression
+	}
+	if e.codec == codecIDMsgpack && compression == compressionNone {
+		return data, nil
+	}
+	header := []byte{headerMark, headerFlag | compression<<headerCompressionBit | e.codec}
+	switch compression {
+	case compressionZstd:
+		return zstdEncoder.EncodeAll(data, header), nil
+	case compressionSnappy:
+		return append(header, snappy.Encode(nil, data)...), nil
+	}
+	return append(header, data...), nil
+}
+
+func isEncodedWithHeader(data []byte) bool {
+	return len(data) >= 2 && data[0] == headerMark && data[1]&headerFlag != 0
+}
+
+// decode decodes data by its header
+func decode(data []byte, out interface{}) error {
+	if !isEncodedWithHeader(data) {
+		return msgpackCodec{}.Unmarshal(data, out)
+	}
+	codec, ok := codecIDs[data[1]&headerCodecMask]
+	if !ok {
+		return fmt.Errorf("no codec found: %d", data[1]&headerCodecMask)
+	}
+	payload := data[2:]
+	var err error
+	switch (data[1] & headerCompressionMask) >> headerCompressionBit {
+	case compressionNone:
+	case compressionZstd:
+		payload, err = zstdDecoder.DecodeAll(payload, nil)
+	case compressionSnappy:
+		payload, err = snappy.Decode(nil, payload)
+	default:
+		err = fmt.Errorf("no compression found: %d", data[1]&headerCompressionMask)
+	}
+	if err != nil {
+		return err
+	}
+	return codec.Unmarshal(payload, out)
+}
+
+type msgpackCodec struct{}
+
+func (msgpackCodec) Name() string { return CodecMsgpack }
+
+func (msgpackCodec) Marshal(v interface{}) ([]byte, error) { return msgpack.Marshal(&v) }
+
+func (msgpackCodec) Unmarshal(data []byte, out interface{}) error {
+	return msgpack.Unmarshal(data, out)
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return CodecJSON }
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) { return json.Marshal(v) }
+
+func (jsonCodec) Unmarshal(data []byte, out interface{}) error { return json.Unmarshal(data, out) }
+
+// protobufCodec only supports the values implementing proto.Message
+type protobufCodec struct{}
+
+func (protobufCodec) Name() string { return CodecProtobuf }
+
+func (protobufCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("protobuf codec: %T is not a proto.Message", v)
+	}
+	return proto.Marshal(m)
+}
+
+func (protobufCodec) Unmarshal(data []byte, out interface{}) error {
+	m, ok := out.(proto.Message)
+	if !ok {
+		return fmt.Errorf("protobuf codec: %T is not a proto.Message", out)
+	}
+	return proto.Unmarshal(data, m)
+}
+
+type gobCodec struct{}
+
+func (gobCodec) Name() string { return CodecGob }
+
+func (gobCodec) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, out interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(out)
+}
@@ -0,0 +1,63 @@
+package cachext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCodec(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	value := user{Name: strings.Repeat("a", 100), Age: 18}
+	for _, codec := range []string{CodecMsgpack, CodecJSON, CodecGob} {
+		for _, compression := range []string{CompressionNone, CompressionZstd, CompressionSnappy} {
+			e, err := newEncoder(codec, compression, 64)
+			assert.Nil(t, err)
+			data, err := e.encode(value)
+			assert.Nil(t, err)
+			compressed := compression != CompressionNone
+			assert.Equal(t, codec != CodecMsgpack || compressed, isEncodedWithHeader(data), codec+" "+compression)
+			assert.False(t, isTombstone(data))
+			var out user
+			assert.Nil(t, decode(data, &out), codec+" "+compression)
+			assert.Equal(t, value, out)
+		}
+	}
+
+	// small values are not compressed
+	e, _ := newEncoder(CodecMsgpack, CompressionZstd, 1024)
+	data, err := e.encode("small")
+	assert.Nil(t, err)
+	assert.False(t, isEncodedWithHeader(data))
+
+	// values cached before the codecs are decoded as msgpack
+	value2 := "hello"
+	data, err = msgpack.Marshal(&value2)
+	assert.Nil(t, err)
+	var out string
+	assert.Nil(t, decode(data, &out))
+	assert.Equal(t, "hello", out)
+
+	e, _ = newEncoder(CodecProtobuf, CompressionSnappy, 0)
+	data, err = e.encode(wrapperspb.String("hello"))
+	assert.Nil(t, err)
+	msg := &wrapperspb.StringValue{}
+	assert.Nil(t, decode(data, msg))
+	assert.Equal(t, "hello", msg.Value)
+	_, err = e.encode("hello")
+	assert.NotNil(t, err)
+
+	_, err = newEncoder("xml", CompressionNone, 0)
+	assert.EqualError(t, err, "no codec found: xml")
+	_, err = newEncoder(CodecJSON, "lz4", 0)
+	assert.EqualError(t, err, "no compression found: lz4")
+	assert.NotNil(t, RegisterCodec(codecIDJSON, jsonCodec{}))
+	assert.NotNil(t, RegisterCodec(16, jsonCodec{}))
+	assert.NotNil(t, decode([]byte{headerMark, headerFlag | 15}, &out))
+}
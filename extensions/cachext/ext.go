@@ -4,43 +4,71 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math/rand"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/shanbay/gobay"
 	"github.com/spf13/viper"
-	"github.com/vmihailenco/msgpack"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
 )
 
 type void struct{}
 
 // CacheExt
 type CacheExt struct {
-	NS             string
-	app            *gobay.Application
-	backend        CacheBackend
-	prefix         string
-	initialized    bool
-	cachedFuncName map[string]void
-	requestCounter *prometheus.CounterVec
-	hitCounter     *prometheus.CounterVec
+	NS               string
+	app              *gobay.Application
+	backend          CacheBackend
+	backendName      string
+	prefix           string
+	initialized      bool
+	cachedFuncName   map[string]void
+	requestCounter   metric.Int64Counter
+	hitCounter       metric.Int64Counter
+	coalescedCounter metric.Int64Counter
+	group            singleflight.Group
+	logger           *slog.Logger
+	encoder          encoder
+}
+
+// Config the config of CacheExt, keys are prefixed with NS
+type Config struct {
+	Backend       string `mapstructure:"backend" validate:"required"`
+	Prefix        string `mapstructure:"prefix"`
+	MonitorEnable bool   `mapstructure:"monitor_enable"`
+	// codec of the values, and compression of the values not smaller than
+	// compression_threshold bytes
+	Codec                string `mapstructure:"codec" default:"msgpack"`
+	Compression          string `mapstructure:"compression" default:"none"`
+	CompressionThreshold int    `mapstructure:"compression_threshold" default:"1024"`
+	// for redis and tiered backend
+	Host     string `mapstructure:"host"`
+	Password string `mapstructure:"password"`
+	DB       int    `mapstructure:"db"`
 }
 
 var (
-	_          gobay.Extension = (*CacheExt)(nil)
-	backendMap                 = map[string](func() CacheBackend){}
+	_          gobay.Extension            = (*CacheExt)(nil)
+	_          gobay.Configurable         = (*CacheExt)(nil)
+	_          gobay.ConfigSchemaExtender = (*CacheExt)(nil)
+	backendMap                            = map[string](func() CacheBackend){}
 	mu         sync.Mutex
 )
 
 const (
 	prefixName = "prefix_name"
 	funcName   = "func_name"
-)
 
-var cacheLabels = []string{prefixName, funcName}
+	meterName = "github.com/shanbay/gobay/extensions/cachext"
+
+	defaultCompressionThreshold = 1024
+)
 
 // CacheBackend
 type CacheBackend interface {
@@ -58,6 +86,66 @@ type CacheBackend interface {
 	CheckHealth(context.Context) error
 }
 
+// ConfigurableBackend is implemented by the backends reading their own
+// config keys under NS, ConfigSchema returns a pointer to a struct like
+// gobay.Configurable. The keys are validated only if the backend is used.
+type ConfigurableBackend interface {
+	ConfigSchema() interface{}
+}
+
+// MeterSetter is implemented by the backends recording metrics. SetMeter is
+// called before Init if monitor_enable is true, with the namespace of the
+// metrics of CacheExt, e.g. cache for NS cache_
+type MeterSetter interface {
+	SetMeter(meter metric.Meter, namespace string) error
+}
+
+// Locker is implemented by the backends supporting locks shared by
+// processes, which are used by the cached functions WithLock
+type Locker interface {
+	// Lock sets key to token with ttl if key does not exist, and returns
+	// whether it is set
+	Lock(ctx context.Context, key, token string, ttl time.Duration) (bool, error)
+	// Unlock deletes key if it is still locked by token
+	Unlock(ctx context.Context, key, token string) error
+}
+
+// PrefixDeleter is implemented by the backends deleting keys by prefix
+type PrefixDeleter interface {
+	// DeletePrefix deletes the keys starting with prefix, and returns the
+	// number of deleted keys
+	DeletePrefix(ctx context.Context, prefix string) (int, error)
+}
+
+// globEscaper escapes the special characters of redis glob patterns
+var globEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+// ScanPattern returns the pattern of redis SCAN matching the keys starting
+// with prefix, for the backends implementing PrefixDeleter
+func ScanPattern(prefix string) string {
+	return globEscaper.Replace(prefix) + "*"
+}
+
+// BackendStats is the stats of a backend since it is initialized
+type BackendStats struct {
+	Entries     int64 `json:"entries"`
+	Bytes       int64 `json:"bytes"`
+	Hits        int64 `json:"hits"`
+	Misses      int64 `json:"misses"`
+	Evictions   int64 `json:"evictions"`
+	Expirations int64 `json:"expirations"`
+}
+
+// StatsReporter is implemented by the backends keeping their stats
+type StatsReporter interface {
+	Stats() BackendStats
+}
+
+// SetLogger implements gobay.LoggerSetter
+func (c *CacheExt) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
 // Init init a cache extension
 func (c *CacheExt) Init(app *gobay.Application) error {
 	if c.NS == "" {
@@ -70,28 +158,133 @@ func (c *CacheExt) Init(app *gobay.Application) error {
 		return nil
 	}
 	c.app = app
+	if c.logger == nil {
+		c.logger = app.Logger()
+	}
 	c.cachedFuncName = make(map[string]void)
 	config := app.Config()
 	config = gobay.GetConfigByPrefix(config, c.NS, true)
 	c.prefix = config.GetString("prefix")
+	codec := config.GetString("codec")
+	if codec == "" {
+		codec = CodecMsgpack
+	}
+	threshold := defaultCompressionThreshold
+	if config.IsSet("compression_threshold") {
+		threshold = config.GetInt("compression_threshold")
+	}
+	var err error
+	if c.encoder, err = newEncoder(codec, config.GetString("compression"), threshold); err != nil {
+		return err
+	}
 	backendConfig := config.GetString("backend")
-	if backendFunc, exist := backendMap[backendConfig]; exist {
-		c.backend = backendFunc()
-		if err := c.backend.Init(config); err != nil {
-			return err
-		}
-	} else {
+	backendFunc, exist := backendMap[backendConfig]
+	if !exist {
 		return errors.New("No backend found for cache_backend:" + backendConfig)
 	}
+	c.backend = backendFunc()
+	c.backendName = backendConfig
+	if setter, ok := c.backend.(gobay.LoggerSetter); ok {
+		setter.SetLogger(c.logger)
+	}
 	if config.GetBool("monitor_enable") {
-		c.requestCounter = newCacheRequestCounter()
-		c.hitCounter = newCacheHitCounter()
+		namespace := strings.TrimSuffix(c.NS, "_")
+		meter := app.Meter(meterName)
+		if c.requestCounter, err = newCacheRequestCounter(meter, namespace); err != nil {
+			return err
+		}
+		if c.hitCounter, err = newCacheHitCounter(meter, namespace); err != nil {
+			return err
+		}
+		if c.coalescedCounter, err = newCacheCoalescedCounter(meter, namespace); err != nil {
+			return err
+		}
+		if setter, ok := c.backend.(MeterSetter); ok {
+			if err := setter.SetMeter(meter, namespace); err != nil {
+				return err
+			}
+		}
+	}
+	if err := c.backend.Init(config); err != nil {
+		return err
 	}
 
 	c.initialized = true
 	return nil
 }
 
+// ConfigSchema implements gobay.Configurable interface
+func (c *CacheExt) ConfigSchema() (string, interface{}) {
+	return c.NS, &Config{}
+}
+
+// ExtraConfigSchemas implements gobay.ConfigSchemaExtender, it returns the
+// schema of the backend if it implements ConfigurableBackend
+func (c *CacheExt) ExtraConfigSchemas(config *viper.Viper) []interface{} {
+	mu.Lock()
+	backendFunc, exist := backendMap[config.GetString("backend")]
+	mu.Unlock()
+	if !exist {
+		return nil
+	}
+	if backend, ok := backendFunc().(ConfigurableBackend); ok {
+		return []interface{}{backend.ConfigSchema()}
+	}
+	return nil
+}
+
+// AdminActions implements gobay.AdminActionProvider
+func (c *CacheExt) AdminActions() map[string]gobay.AdminAction {
+	return map[string]gobay.AdminAction{
+		// delete the keys given by the key parameters, without the prefix
+		"delete": func(ctx context.Context, params url.Values) (interface{}, error) {
+			keys := params["key"]
+			if len(keys) == 0 {
+				return nil, errors.New("lack of key")
+			}
+			return c.DeleteMany(ctx, keys...), nil
+		},
+		// delete the keys starting with the prefix parameter, without the
+		// prefix of the cache
+		"flush": func(ctx context.Context, params url.Values) (interface{}, error) {
+			return c.DeletePrefix(ctx, params.Get("prefix"))
+		},
+		// the stats of the backend
+		"stats": func(ctx context.Context, params url.Values) (interface{}, error) {
+			stats, ok := c.Stats()
+			if !ok {
+				return nil, errors.New("backend does not report stats: " + c.backendName)
+			}
+			return stats, nil
+		},
+	}
+}
+
+// DeletePrefix deletes the keys starting with prefix, which does not
+// include the prefix of the cache, and returns the number of deleted keys.
+// The backend must implement PrefixDeleter. Deleting all keys of a cache
+// without prefix is refused, as the keys may be shared with others.
+func (c *CacheExt) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	deleter, ok := c.backend.(PrefixDeleter)
+	if !ok {
+		return 0, errors.New("backend does not support deleting by prefix: " + c.backendName)
+	}
+	if c.transKey(prefix) == "" {
+		return 0, errors.New("refuse to delete all keys of a cache without prefix")
+	}
+	return deleter.DeletePrefix(ctx, c.transKey(prefix))
+}
+
+// Stats returns the stats of the backend, ok is false if the backend does
+// not implement StatsReporter
+func (c *CacheExt) Stats() (stats BackendStats, ok bool) {
+	reporter, ok := c.backend.(StatsReporter)
+	if !ok {
+		return BackendStats{}, false
+	}
+	return reporter.Stats(), true
+}
+
 // CheckHealth - Check if extension is healthy
 func (c *CacheExt) CheckHealth(ctx context.Context) error {
 	err := c.backend.CheckHealth(ctx)
@@ -152,9 +345,12 @@ func (c *CacheExt) transKey(key string) string {
 }
 
 // Get
-func (c *CacheExt) Get(ctx context.Context, key string, m interface{}) (bool, error) {
+func (c *CacheExt) Get(ctx context.Context, key string, m interface{}) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "cache.get")
+	defer func() { span.End(err) }()
 	transedKey := c.transKey(key)
 	data, err := c.backend.Get(ctx, transedKey)
+	span.SetAttributes(attribute.Bool(attrHit, data != nil), attribute.Int(attrPayloadSize, len(data)))
 	if data == nil {
 		return false, err
 	}
@@ -162,30 +358,40 @@ func (c *CacheExt) Get(ctx context.Context, key string, m interface{}) (bool, er
 }
 
 // Set
-func (c *CacheExt) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+func (c *CacheExt) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) (err error) {
+	ctx, span := c.startSpan(ctx, "cache.set")
+	defer func() { span.End(err) }()
 	transedKey := c.transKey(key)
-	encodedValue, err := encode(value)
+	encodedValue, err := c.encoder.encode(value)
 	if err != nil {
 		return err
 	}
+	span.SetAttributes(attribute.Int(attrPayloadSize, len(encodedValue)))
 	return c.backend.Set(ctx, transedKey, encodedValue, ttl)
 }
 
 // SetMany
-func (c *CacheExt) SetMany(ctx context.Context, keyValues map[string]interface{}, ttl time.Duration) error {
+func (c *CacheExt) SetMany(ctx context.Context, keyValues map[string]interface{}, ttl time.Duration) (err error) {
+	ctx, span := c.startSpan(ctx, "cache.set_many", attribute.Int(attrKeys, len(keyValues)))
+	defer func() { span.End(err) }()
 	transedMap := make(map[string][]byte)
+	size := 0
 	for key, value := range keyValues {
-		if encodedValue, err := encode(value); err != nil {
+		if encodedValue, err := c.encoder.encode(value); err != nil {
 			return err
 		} else {
 			transedMap[c.transKey(key)] = encodedValue
+			size += len(encodedValue)
 		}
 	}
+	span.SetAttributes(attribute.Int(attrPayloadSize, size))
 	return c.backend.SetMany(ctx, transedMap, ttl)
 }
 
 // GetMany out map[string]*someStruct
-func (c *CacheExt) GetMany(ctx context.Context, out map[string]interface{}) error {
+func (c *CacheExt) GetMany(ctx context.Context, out map[string]interface{}) (err error) {
+	ctx, span := c.startSpan(ctx, "cache.get_many", attribute.Int(attrKeys, len(out)))
+	defer func() { span.End(err) }()
 	transedKeys := []string{}
 	transedKey2key := make(map[string]string)
 	for key := range out {
@@ -193,9 +399,12 @@ func (c *CacheExt) GetMany(ctx context.Context, out map[string]interface{}) erro
 		transedKeys = append(transedKeys, transedKey)
 		transedKey2key[transedKey] = key
 	}
+	hits, size := 0, 0
 	for i, value := range c.backend.GetMany(ctx, transedKeys) {
 		key := transedKey2key[transedKeys[i]]
 		if value != nil {
+			hits++
+			size += len(value)
 			if err := decode(value, out[key]); err != nil {
 				return err
 			}
@@ -203,11 +412,14 @@ func (c *CacheExt) GetMany(ctx context.Context, out map[string]interface{}) erro
 			out[key] = nil
 		}
 	}
+	span.SetAttributes(attribute.Int(attrHits, hits), attribute.Int(attrPayloadSize, size))
 	return nil
 }
 
 // Delete
 func (c *CacheExt) Delete(ctx context.Context, key string) bool {
+	ctx, span := c.startSpan(ctx, "cache.delete")
+	defer span.End(nil)
 	return c.backend.Delete(ctx, c.transKey(key))
 }
 
@@ -235,36 +447,23 @@ func (c *CacheExt) Exists(ctx context.Context, key string) bool {
 	return c.backend.Exists(ctx, c.transKey(key))
 }
 
-func encode(value interface{}) ([]byte, error) {
-	jsonBytes, err := msgpack.Marshal(&value)
-	if err != nil {
-		return nil, err
-	}
-	return jsonBytes, nil
+// Create a counter of total cache requests, named
+// <namespace>_request_counter, e.g. cache_request_counter for NS cache_
+func newCacheRequestCounter(meter metric.Meter, namespace string) (metric.Int64Counter, error) {
+	return meter.Int64Counter(namespace+"_request_counter",
+		metric.WithDescription("Number of cache requests"))
 }
 
-func decode(data []byte, out interface{}) error {
-	return msgpack.Unmarshal(data, out)
+// Create a counter of cache hits, named <namespace>_hit_counter
+func newCacheHitCounter(meter metric.Meter, namespace string) (metric.Int64Counter, error) {
+	return meter.Int64Counter(namespace+"_hit_counter",
+		metric.WithDescription("Number of cache hits"))
 }
 
-// Create a collector for total cache request counter
-func newCacheRequestCounter() *prometheus.CounterVec {
-	return promauto.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cache_request_counter",
-			Help: "Number of cache requests",
-		},
-		cacheLabels,
-	)
-}
-
-// Create a collector for cache hit counter
-func newCacheHitCounter() *prometheus.CounterVec {
-	return promauto.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cache_hit_counter",
-			Help: "Number of cache hits",
-		},
-		cacheLabels,
-	)
+// Create a counter of the cached function calls which wait for the result
+// of another call instead of calling the function, named
+// <namespace>_coalesced_counter
+func newCacheCoalescedCounter(meter metric.Meter, namespace string) (metric.Int64Counter, error) {
+	return meter.Int64Counter(namespace+"_coalesced_counter",
+		metric.WithDescription("Number of coalesced cached function calls"))
 }
@@ -2,20 +2,30 @@ package cachext_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/shanbay/gobay"
 	"github.com/shanbay/gobay/extensions/cachext"
 	_ "github.com/shanbay/gobay/extensions/cachext/backend/memory"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func ExampleCacheExt_Set() {
@@ -579,29 +589,24 @@ func TestCacheExt_Cached_Monitor(t *testing.T) {
 	exts := map[gobay.Key]gobay.Extension{
 		"cache": cache,
 	}
-	_, err := gobay.CreateApp("../../testdata/", "cachemonitored", exts)
+	app, err := gobay.CreateApp("../../testdata/", "cachemonitored", exts)
 	assert.Nil(t, err)
 
+	srv := httptest.NewServer(app.MetricsHandler())
+	defer srv.Close()
 	fetchMetricData := func() string {
-		resp, err := http.Get("http://localhost:2112/metrics")
+		resp, err := http.Get(srv.URL)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 		defer resp.Body.Close()
 		rawData, err := io.ReadAll(resp.Body)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 		return string(rawData)
 	}
 
-	go func() {
-		http.Handle("/metrics", promhttp.Handler())
-		if err := http.ListenAndServe(":2112", nil); err != nil {
-			log.Fatalf("error when start prometheus server: %v\n", err)
-		}
-	}()
-
 	// Cache method
 	f_str := func(_ context.Context, keys []string, args []int64) (interface{}, error) {
 		return keys[0], nil
@@ -621,3 +626,387 @@ func TestCacheExt_Cached_Monitor(t *testing.T) {
 	assert.Contains(t, data, `cache_request_counter{func_name="f_str",prefix_name="github"} 2`)
 	assert.Contains(t, data, `cache_hit_counter{func_name="f_str",prefix_name="github"} 1`)
 }
+
+func TestCacheExt_Monitor_MultipleApps(t *testing.T) {
+	config := map[string]interface{}{
+		"cache_backend":        "memory",
+		"cache_monitor_enable": true,
+		"other_backend":        "memory",
+		"other_monitor_enable": true,
+	}
+	for i := 0; i < 2; i++ {
+		// registering into the app registry never conflicts between apps
+		app, err := gobay.CreateAppFromMap(config, map[gobay.Key]gobay.Extension{
+			"cache": &cachext.CacheExt{NS: "cache_"},
+			"other": &cachext.CacheExt{NS: "other_"},
+		})
+		assert.Nil(t, err)
+		metrics, err := app.Registry().Gather()
+		assert.Nil(t, err)
+		names := make([]string, 0, len(metrics))
+		for _, m := range metrics {
+			names = append(names, m.GetName())
+		}
+		// counters without samples are not gathered
+		assert.Empty(t, names)
+
+		other := gobay.MustGet[*cachext.CacheExt](app, "other")
+		c := other.Cached("f", func(context.Context, []string, []int64) (interface{}, error) {
+			return "v", nil
+		})
+		var out string
+		assert.Nil(t, c.GetResult(context.Background(), &out, []string{"k"}, nil))
+		metrics, err = app.Registry().Gather()
+		assert.Nil(t, err)
+		assert.Len(t, metrics, 1)
+		assert.Equal(t, "other_request_counter", metrics[0].GetName())
+	}
+}
+
+func TestCacheExt_Tracing(t *testing.T) {
+	t.Setenv("OTEL_ENABLE", "true")
+	t.Setenv("OTEL_TRACES_EXPORTER", "none")
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateApp("../../testdata/", "testing", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+	// replace the tracer provider set by the app
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prev)
+	ctx := context.Background()
+	var out string
+	assert.Nil(t, cache.Set(ctx, "tracing_key", "hello", 10*time.Second))
+	_, err = cache.Get(ctx, "tracing_key", &out)
+	assert.Nil(t, err)
+	assert.Nil(t, cache.GetMany(ctx, map[string]interface{}{"tracing_key": &out, "tracing_none": nil}))
+	cache.Delete(ctx, "tracing_key")
+	c := cache.Cached("tracing_f", func(context.Context, []string, []int64) (interface{}, error) {
+		return "v", nil
+	})
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"k"}, nil))
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"k"}, nil))
+
+	attrs := func(s sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		res := make(map[attribute.Key]attribute.Value)
+		for _, kv := range s.Attributes() {
+			res[kv.Key] = kv.Value
+		}
+		return res
+	}
+	var names []string
+	var cached []map[attribute.Key]attribute.Value
+	for _, s := range recorder.Ended() {
+		names = append(names, s.Name())
+		a := attrs(s)
+		assert.Equal(t, "memory", a["cache.backend"].AsString())
+		assert.Equal(t, "github", a["cache.prefix"].AsString())
+		switch s.Name() {
+		case "cache.get":
+			assert.True(t, a["cache.hit"].AsBool())
+			assert.Equal(t, int64(6), a["cache.payload_size"].AsInt64())
+		case "cache.get_many":
+			assert.Equal(t, int64(2), a["cache.keys"].AsInt64())
+			assert.Equal(t, int64(1), a["cache.hits"].AsInt64())
+		case "cache.cached":
+			assert.Equal(t, "tracing_f", a["cache.func"].AsString())
+			cached = append(cached, a)
+		}
+	}
+	assert.Equal(t, []string{
+		"cache.set", "cache.get", "cache.get_many", "cache.delete",
+		"cache.call", "cache.decode", "cache.cached",
+		"cache.decode", "cache.cached",
+	}, names)
+	assert.False(t, cached[0]["cache.hit"].AsBool())
+	assert.True(t, cached[1]["cache.hit"].AsBool())
+}
+
+func TestCacheExt_Cached_Singleflight(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	app, err := gobay.CreateApp("../../testdata/", "cachemonitored", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+
+	var calls int32
+	release := make(chan struct{})
+	c := cache.Cached("singleflight_f", func(context.Context, []string, []int64) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "v", nil
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out string
+			assert.Nil(t, c.GetResult(context.Background(), &out, []string{"k"}, nil))
+			assert.Equal(t, "v", out)
+		}()
+	}
+	// wait for the callers to miss the cache
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	rec := httptest.NewRecorder()
+	app.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, rec.Body.String(),
+		`cache_coalesced_counter{by="process",func_name="singleflight_f",prefix_name="github"} 9`)
+}
+
+func TestCacheExt_Cached_SingleflightCanceled(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateApp("../../testdata/", "testing", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	c := cache.Cached("singleflight_canceled_f", func(ctx context.Context, _ []string, _ []int64) (interface{}, error) {
+		close(started)
+		<-release
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return "v", nil
+	})
+
+	// the caller starting the call gives up
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error)
+	go func() {
+		var out string
+		leaderErr <- c.GetResult(ctx, &out, []string{"k"}, nil)
+	}()
+	<-started
+	follower := make(chan error)
+	var out string
+	go func() {
+		follower <- c.GetResult(context.Background(), &out, []string{"k"}, nil)
+	}()
+	// wait for the follower to join the call
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.Equal(t, context.Canceled, <-leaderErr)
+
+	// the other callers still get the result
+	close(release)
+	assert.Nil(t, <-follower)
+	assert.Equal(t, "v", out)
+}
+
+func TestCacheExt_Cached_Lock(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateApp("../../testdata/", "testing", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	var calls int32
+	c := cache.Cached("lock_f", func(context.Context, []string, []int64) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return "v", nil
+	}, cachext.WithLock(time.Second, 200*time.Millisecond), cachext.WithStaleTTL(time.Minute))
+	cacheKey := c.MakeCacheKey([]string{"k"}, nil)
+	var out string
+
+	// another process holds the lock and caches the result
+	assert.Nil(t, cache.Set(ctx, cacheKey+"&lock", "token", time.Second))
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		assert.Nil(t, cache.Set(ctx, cacheKey, "other", time.Minute))
+	}()
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"k"}, nil))
+	assert.Equal(t, "other", out)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	// the process holding the lock is too slow
+	cache.Delete(ctx, cacheKey)
+	start := time.Now()
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"k"}, nil))
+	assert.True(t, time.Since(start) >= 200*time.Millisecond)
+	assert.Equal(t, "v", out)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// serve the stale result while another process holds the lock
+	cache.Delete(ctx, cacheKey)
+	start = time.Now()
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"k"}, nil))
+	assert.True(t, time.Since(start) < 200*time.Millisecond)
+	assert.Equal(t, "v", out)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// call the function after the lock is released
+	cache.Delete(ctx, cacheKey+"&lock")
+	cache.Delete(ctx, cacheKey)
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"k"}, nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.False(t, cache.Exists(ctx, cacheKey+"&lock"))
+}
+
+func TestCacheExt_Cached_Negative(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateApp("../../testdata/", "testing", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	errNotExist := errors.New("user not found")
+	var calls int
+	c := cache.Cached("negative_f", func(_ context.Context, strArgs []string, _ []int64) (interface{}, error) {
+		calls++
+		switch strArgs[0] {
+		case "nil":
+			return (*string)(nil), nil
+		case "missing":
+			return nil, fmt.Errorf("get user: %w", errNotExist)
+		case "failed":
+			return nil, errors.New("db is down")
+		}
+		return strArgs[0], nil
+	}, cachext.WithTTL(time.Minute), cachext.WithNegativeTTL(time.Second, func(err error) bool {
+		return errors.Is(err, errNotExist)
+	}, errNotExist))
+
+	for i := 0; i < 2; i++ {
+		out := "content"
+		assert.Nil(t, c.GetResult(ctx, &out, []string{"nil"}, nil))
+		assert.Equal(t, "", out)
+	}
+	assert.Equal(t, 1, calls)
+	ttl := cache.TTL(ctx, c.MakeCacheKey([]string{"nil"}, nil))
+	assert.True(t, ttl > 0 && ttl <= time.Second)
+
+	// the error of the miss is the same as the error of the tombstone hit,
+	// both match the not found error
+	var out string
+	missErr := c.GetResult(ctx, &out, []string{"missing"}, nil)
+	hitErr := c.GetResult(ctx, &out, []string{"missing"}, nil)
+	assert.Equal(t, 2, calls)
+	for _, err := range []error{missErr, hitErr} {
+		assert.True(t, errors.Is(err, cachext.ErrNotFound))
+		assert.True(t, errors.Is(err, errNotExist))
+		assert.Equal(t, "get user: user not found", err.Error())
+	}
+	assert.Equal(t, missErr, hitErr)
+
+	// other errors are not cached
+	for i := 0; i < 2; i++ {
+		err = c.GetResult(ctx, &out, []string{"failed"}, nil)
+		assert.EqualError(t, err, "db is down")
+		assert.False(t, errors.Is(err, cachext.ErrNotFound))
+	}
+	assert.Equal(t, 4, calls)
+
+	// the tombstones expire
+	time.Sleep(time.Second)
+	assert.NotNil(t, c.GetResult(ctx, &out, []string{"missing"}, nil))
+	assert.Equal(t, 5, calls)
+
+	assert.Nil(t, c.GetResult(ctx, &out, []string{"found"}, nil))
+	assert.Equal(t, "found", out)
+	ttl = cache.TTL(ctx, c.MakeCacheKey([]string{"found"}, nil))
+	assert.True(t, ttl > time.Second)
+}
+
+func TestCacheExt_ConfigSchema(t *testing.T) {
+	dir := t.TempDir()
+	config := "testing:\n  cache_backnd: memory\n  cache_prefix: github\n"
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	exts := map[gobay.Key]gobay.Extension{
+		"cache": &cachext.CacheExt{NS: "cache_"},
+	}
+	err := gobay.ValidateConfig(dir, "testing", exts)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cache: unknown config cache_backnd")
+	assert.Contains(t, err.Error(), "cache: missing required config cache_backend")
+
+	// the keys of the backend are validated by its schema
+	config = "testing:\n  cache_backend: memory\n  cache_memory_shards: 4\n  cache_local_size: 10\n"
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = gobay.ValidateConfig(dir, "testing", exts)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cache: unknown config cache_local_size")
+	assert.NotContains(t, err.Error(), "cache_memory_shards")
+}
+
+func TestCacheExt_Stats(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateApp("../../testdata/", "testing", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	before, ok := cache.Stats()
+	assert.True(t, ok)
+	assert.Nil(t, cache.Set(ctx, "stats_key", "v", time.Minute))
+	var out string
+	_, err = cache.Get(ctx, "stats_key", &out)
+	assert.Nil(t, err)
+
+	res, err := cache.AdminActions()["stats"](ctx, nil)
+	assert.Nil(t, err)
+	stats := res.(cachext.BackendStats)
+	assert.Equal(t, before.Entries+1, stats.Entries)
+	assert.Equal(t, before.Hits+1, stats.Hits)
+}
+
+func TestCacheExt_Codec(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateAppFromMap(map[string]interface{}{
+		"cache_backend":               "memory",
+		"cache_codec":                 "json",
+		"cache_compression":           "zstd",
+		"cache_compression_threshold": 16,
+	}, map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	value := map[string]string{"name": strings.Repeat("a", 100)}
+	assert.Nil(t, cache.Set(ctx, "codec_key", value, time.Minute))
+	out := map[string]string{}
+	ok, err := cache.Get(ctx, "codec_key", &out)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.Equal(t, value, out)
+
+	c := cache.Cached("codec_f", func(_ context.Context, strArgs []string, _ []int64) (interface{}, error) {
+		return wrapperspb.String(strArgs[0]), nil
+	}, cachext.WithCodec(cachext.CodecProtobuf))
+	for i := 0; i < 2; i++ {
+		msg := &wrapperspb.StringValue{}
+		assert.Nil(t, c.GetResult(ctx, msg, []string{"hello"}, nil))
+		assert.Equal(t, "hello", msg.Value)
+	}
+	assert.Panics(t, func() {
+		cache.Cached("codec_f2", nil, cachext.WithCodec("xml"))
+	})
+
+	_, err = gobay.CreateAppFromMap(map[string]interface{}{
+		"cache_backend": "memory",
+		"cache_codec":   "xml",
+	}, map[gobay.Key]gobay.Extension{"cache": &cachext.CacheExt{NS: "cache_"}})
+	assert.NotNil(t, err)
+}
+
+func TestCacheExt_DeletePrefix(t *testing.T) {
+	cache := &cachext.CacheExt{NS: "cache_"}
+	_, err := gobay.CreateApp("../../testdata/", "testing", map[gobay.Key]gobay.Extension{"cache": cache})
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	for _, key := range []string{"flush_a", "flush_b", "flushed", "other"} {
+		assert.Nil(t, cache.Set(ctx, key, "v", time.Minute))
+	}
+	res, err := cache.AdminActions()["flush"](ctx, url.Values{"prefix": {"flush_"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, res)
+	assert.False(t, cache.Exists(ctx, "flush_a"))
+	assert.False(t, cache.Exists(ctx, "flush_b"))
+	assert.True(t, cache.Exists(ctx, "flushed"))
+	assert.True(t, cache.Exists(ctx, "other"))
+
+	assert.Equal(t, `a\*b\?\[c\]\\*`, cachext.ScanPattern(`a*b?[c]\`))
+}
@@ -0,0 +1,95 @@
+package cachext
+
+import (
+	"errors"
+	"reflect"
+	"time"
+)
+
+// ErrNotFound is matched by the errors returned by GetResult for the not
+// found errors cached by WithNegativeTTL, check them with errors.Is
+var ErrNotFound = errors.New("cachext: not found")
+
+// NotFoundError is returned by GetResult for the not found errors cached by
+// WithNegativeTTL, whether they are returned by the function or read from the
+// cache. Only the message of the original error is cached, so it wraps the
+// not found error passed to WithNegativeTTL instead of the original error.
+type NotFoundError struct {
+	Msg string
+	err error
+}
+
+func (e *NotFoundError) Error() string { return e.Msg }
+
+func (e *NotFoundError) Unwrap() error { return e.err }
+
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// A tombstone is cached for the nil results and the not found errors. It
+// starts with 0xc1, which is never used by msgpack, followed by the kind
+// and the message of the error. The kinds never collide with the headers of
+// the encoded values, see codec.go.
+const (
+	tombstoneMark  = 0xc1
+	tombstoneNil   = 'n'
+	tombstoneError = 'e'
+)
+
+// msgpackNil is the encoded nil, which is decoded for the tombstones of nil
+// results as if the nil results were cached
+var msgpackNil = []byte{0xc0}
+
+func isTombstone(data []byte) bool {
+	return len(data) >= 2 && data[0] == tombstoneMark &&
+		(data[1] == tombstoneNil || data[1] == tombstoneError)
+}
+
+func nilTombstone() []byte {
+	return []byte{tombstoneMark, tombstoneNil}
+}
+
+func errorTombstone(err error) []byte {
+	return append([]byte{tombstoneMark, tombstoneError}, err.Error()...)
+}
+
+// decodeTombstone decodes the tombstone into out for nil results, or
+// returns the cached error wrapping notFound
+func decodeTombstone(data []byte, out interface{}, notFound error) error {
+	if data[1] == tombstoneError {
+		return &NotFoundError{Msg: string(data[2:]), err: notFound}
+	}
+	return msgpackCodec{}.Unmarshal(msgpackNil, out)
+}
+
+// isNil returns whether the result of the function is nil, including the
+// nil pointers, maps and slices
+func isNil(res interface{}) bool {
+	if res == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(res); v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// WithNegativeTTL caches the nil results of the function, and the errors
+// matched by isNotFound, e.g. ent.IsNotFound, as tombstones for ttl. The
+// tombstones are returned as the empty result, or a NotFoundError with the
+// message of the original error. The NotFoundError wraps notFound, e.g.
+// sql.ErrNoRows or &ent.NotFoundError{}, so that the checks of the original
+// error keep working, on the first call as well as on the cached ones.
+// isNotFound can be nil to cache nil results only, and notFound can be nil
+// if the errors are only checked with ErrNotFound.
+func WithNegativeTTL(ttl time.Duration, isNotFound func(error) bool, notFound error) cacheOption {
+	return func(config *CachedConfig) error {
+		if ttl <= 0 {
+			return errors.New("negative ttl should be positive duration")
+		}
+		config.negativeTTL = ttl
+		config.isNotFound = isNotFound
+		config.notFound = notFound
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package cachext
+
+import (
+	"context"
+
+	"github.com/shanbay/gobay/observability"
+	"go.elastic.co/apm"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attributes of the cache spans
+const (
+	attrBackend     = "cache.backend"
+	attrPrefix      = "cache.prefix"
+	attrFunc        = "cache.func"
+	attrHit         = "cache.hit"
+	attrPayloadSize = "cache.payload_size"
+	attrKeys        = "cache.keys"
+	attrHits        = "cache.hits"
+	attrNegative    = "cache.negative"
+
+	apmSpanType = "cache"
+)
+
+// span is a span of APM and/or OpenTelemetry, depending on which of them
+// is enabled. It does nothing if neither is enabled.
+type span struct {
+	apmSpan  *apm.Span
+	otelSpan trace.Span
+}
+
+// startSpan starts a span with the backend and prefix of c as attributes
+func (c *CacheExt) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, *span) {
+	s := &span{}
+	attrs = append(attrs,
+		attribute.String(attrBackend, c.backendName),
+		attribute.String(attrPrefix, c.prefix),
+	)
+	if observability.GetOtelEnable() {
+		ctx, s.otelSpan = otel.Tracer(meterName).Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+	}
+	if observability.GetApmEnable() {
+		s.apmSpan, ctx = apm.StartSpan(ctx, name, apmSpanType)
+		s.setLabels(attrs)
+	}
+	return ctx, s
+}
+
+// SetAttributes sets attributes of the OpenTelemetry span, and labels of the
+// APM span
+func (s *span) SetAttributes(attrs ...attribute.KeyValue) {
+	if s.otelSpan != nil {
+		s.otelSpan.SetAttributes(attrs...)
+	}
+	if s.apmSpan != nil {
+		s.setLabels(attrs)
+	}
+}
+
+func (s *span) setLabels(attrs []attribute.KeyValue) {
+	for _, attr := range attrs {
+		s.apmSpan.Context.SetLabel(string(attr.Key), attr.Value.AsInterface())
+	}
+}
+
+// End ends the span, which fails if err is not nil
+func (s *span) End(err error) {
+	if s.otelSpan != nil {
+		if err != nil {
+			s.otelSpan.RecordError(err)
+			s.otelSpan.SetStatus(codes.Error, err.Error())
+		}
+		s.otelSpan.End()
+	}
+	if s.apmSpan != nil {
+		if err != nil {
+			s.apmSpan.Outcome = "failure"
+		}
+		s.apmSpan.End()
+	}
+}
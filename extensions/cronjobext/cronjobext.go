@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/config"
-	"github.com/RichardKnop/machinery/v1/log"
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/go-co-op/gocron"
 	"github.com/mitchellh/mapstructure"
@@ -36,6 +38,10 @@ func (c *Config) TZ() (*time.Location, error) {
 
 type CronJobExt struct {
 	NS string
+	// AsyncTaskExtName key of an AsyncTaskExt registered in the same app.
+	// When set, the cronjob sends tasks through that extension instead of
+	// creating its own from the bind_to config.
+	AsyncTaskExtName gobay.Key
 	// TimeZone scheduler's timezone
 	TimeZone *time.Location
 
@@ -46,17 +52,30 @@ type CronJobExt struct {
 	scheduler         *gocron.Scheduler
 	registeredTasks   *sync.Map
 	healthCheckServer *http.Server
+	logger            *slog.Logger
+	paused            atomic.Bool
+	// started is set by StartCronJob, the scheduler is only checked in the
+	// process running it
+	started atomic.Bool
 }
 
 func (t *CronJobExt) Object() interface{} {
 	return t
 }
 
+// SetLogger implements gobay.LoggerSetter
+func (t *CronJobExt) SetLogger(logger *slog.Logger) {
+	t.logger = logger
+}
+
 func (t *CronJobExt) Init(app *gobay.Application) error {
 	if t.NS == "" {
 		return errors.New("lack of NS")
 	}
 	t.app = app
+	if t.logger == nil {
+		t.logger = app.Logger()
+	}
 	extCfg := app.Config()
 	extCfg = gobay.GetConfigByPrefix(extCfg, t.NS, true)
 	t.config = &Config{AsyncTaskConfig: &config.Config{}, TimeZone: "UTC", HealthCheckPort: 5000}
@@ -66,6 +85,36 @@ func (t *CronJobExt) Init(app *gobay.Application) error {
 		return err
 	}
 
+	if t.AsyncTaskExtName != "" {
+		server, ok := app.Get(t.AsyncTaskExtName).Object().(*asynctaskext.AsyncTaskExt)
+		if !ok {
+			return fmt.Errorf("extension %s is not a AsyncTaskExt", t.AsyncTaskExtName)
+		}
+		t.server = server
+		t.config.AsyncTaskConfig = server.Config()
+	} else if err := t.initAsyncTask(app); err != nil {
+		return err
+	}
+
+	tz, err := t.config.TZ()
+	if err != nil {
+		return err
+	}
+	t.scheduler = gocron.NewScheduler(tz)
+	t.TimeZone = tz
+	t.registeredTasks = &sync.Map{}
+	return nil
+}
+
+// DependsOn implements gobay.Dependent interface
+func (t *CronJobExt) DependsOn() []gobay.Key {
+	if t.AsyncTaskExtName == "" {
+		return nil
+	}
+	return []gobay.Key{t.AsyncTaskExtName}
+}
+
+func (t *CronJobExt) initAsyncTask(app *gobay.Application) error {
 	// bind to other AsyncTaskExt configurations
 	asyncTaskNS := t.config.BindTo
 	asyncExtCfg := app.Config()
@@ -80,18 +129,8 @@ func (t *CronJobExt) Init(app *gobay.Application) error {
 	t.server = &asynctaskext.AsyncTaskExt{
 		NS: asyncTaskNS,
 	}
-	if err := t.server.Init(app); err != nil {
-		return err
-	}
-
-	tz, err := t.config.TZ()
-	if err != nil {
-		return err
-	}
-	t.scheduler = gocron.NewScheduler(tz)
-	t.TimeZone = tz
-	t.registeredTasks = &sync.Map{}
-	return nil
+	t.server.SetLogger(t.logger)
+	return t.server.Init(app)
 }
 
 func (t *CronJobExt) Application() *gobay.Application {
@@ -130,14 +169,47 @@ type CronJobTask struct {
 func (t *CronJobExt) jobWrapper(task *CronJobTask) func() error {
 	return func() error {
 		signature := tasks.CopySignature(task.TaskSignature)
+		if t.paused.Load() {
+			t.logger.Info("skip task, cronjob is paused", "task", signature.Name)
+			return nil
+		}
 		_, err := t.server.SendTask(signature)
 		if err != nil {
-			log.ERROR.Printf("send task failed: %v", err)
+			t.logger.Error("send task failed", "task", signature.Name, "error", err)
 		}
 		return err
 	}
 }
 
+// Pause skips sending tasks until Resume is called, the scheduler keeps running
+func (t *CronJobExt) Pause() {
+	t.paused.Store(true)
+}
+
+// Resume sends tasks again after Pause
+func (t *CronJobExt) Resume() {
+	t.paused.Store(false)
+}
+
+// Paused returns true if the cronjob is paused
+func (t *CronJobExt) Paused() bool {
+	return t.paused.Load()
+}
+
+// AdminActions implements gobay.AdminActionProvider
+func (t *CronJobExt) AdminActions() map[string]gobay.AdminAction {
+	return map[string]gobay.AdminAction{
+		"pause": func(context.Context, url.Values) (interface{}, error) {
+			t.Pause()
+			return t.Paused(), nil
+		},
+		"resume": func(context.Context, url.Values) (interface{}, error) {
+			t.Resume()
+			return t.Paused(), nil
+		},
+	}
+}
+
 // RemoveAllJobs stops and deletes all scheduled tasks, but the scheduler itself does not stop running
 func (t *CronJobExt) RemoveAllJobs() {
 	t.scheduler.Clear()
@@ -179,35 +251,48 @@ func (t *CronJobExt) StartCronJob(enableHealthCheck bool) {
 	if t.scheduler.IsRunning() {
 		return
 	}
+	t.started.Store(true)
 	if enableHealthCheck {
-		t.healthCheckServer = &http.Server{Addr: fmt.Sprintf(":%v", t.config.HealthCheckPort)}
-		http.Handle("/health", http.HandlerFunc(t.healthHttpHandler))
+		mux := http.NewServeMux()
+		mux.Handle("/health", http.HandlerFunc(t.healthHttpHandler))
+		mux.Handle("/healthz", t.app.LivenessHandler())
+		mux.Handle("/readyz", t.app.ReadinessHandler())
+		t.healthCheckServer = &http.Server{Addr: fmt.Sprintf(":%v", t.config.HealthCheckPort), Handler: mux}
 		go func() {
 			if err := t.healthCheckServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-				log.FATAL.Printf("error when starting health check server: %v\n", err)
+				t.logger.Error("error when starting health check server", "error", err)
 			}
 		}()
 	}
 	t.scheduler.StartBlocking()
 }
 
-func (t *CronJobExt) healthHttpHandler(w http.ResponseWriter, _ *http.Request) {
+// CheckHealth implements gobay.HealthChecker, it fails if the scheduler is
+// not running or any job failed after StartCronJob is called, so it does
+// nothing in processes which never start the scheduler, e.g. API servers
+// sharing the extensions.
+func (t *CronJobExt) CheckHealth(ctx context.Context) error {
+	if !t.started.Load() {
+		return nil
+	}
 	if !t.scheduler.IsRunning() {
-		w.WriteHeader(http.StatusBadRequest)
-		if _, err := w.Write([]byte("scheduler down!")); err != nil {
-			panic(err)
-		}
-		return
+		return errors.New("scheduler down!")
 	}
-
 	for _, j := range t.scheduler.Jobs() {
 		if j.Error() != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			if _, err := w.Write([]byte(j.Error().Error())); err != nil {
-				panic(err)
-			}
-			return
+			return j.Error()
+		}
+	}
+	return nil
+}
+
+func (t *CronJobExt) healthHttpHandler(w http.ResponseWriter, r *http.Request) {
+	if err := t.CheckHealth(r.Context()); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		if _, err := w.Write([]byte(err.Error())); err != nil {
+			panic(err)
 		}
+		return
 	}
 
 	w.WriteHeader(http.StatusOK)
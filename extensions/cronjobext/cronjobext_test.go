@@ -1,6 +1,7 @@
 package cronjobext
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/shanbay/gobay"
+	"github.com/shanbay/gobay/extensions/asynctaskext"
 )
 
 var (
@@ -308,6 +310,13 @@ func TestRegisterCronjobTask(t *testing.T) {
 	}
 }
 
+func TestHealthCheckNotStarted(t *testing.T) {
+	// the processes never starting the scheduler are healthy
+	if err := cronjobTwo.CheckHealth(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestHealthCheck(t *testing.T) {
 	cronjobs := map[string]*CronJobTask{
 		"sub": {
@@ -343,3 +352,41 @@ func TestHealthCheck(t *testing.T) {
 	}
 	cronjobOne.RemoveAllJobs()
 }
+
+func TestReuseAsyncTaskExt(t *testing.T) {
+	asyncTask := &asynctaskext.AsyncTaskExt{NS: "one_asynctask_"}
+	cronjob := &CronJobExt{NS: "two_cronjob_", AsyncTaskExtName: "asynctask"}
+	_, err := gobay.CreateApp(
+		"../../testdata",
+		"testing",
+		map[gobay.Key]gobay.Extension{
+			"asynctask": asyncTask,
+			"cronjob":   cronjob,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cronjob.server != asyncTask {
+		t.Errorf("reuse error: cronjob does not use the registered AsyncTaskExt")
+	}
+	if cronjob.config.AsyncTaskConfig.DefaultQueue != "gobay.task.one" {
+		t.Errorf("reuse error: want: %v got: %v", "gobay.task.one", cronjob.config.AsyncTaskConfig.DefaultQueue)
+	}
+}
+
+func TestPause(t *testing.T) {
+	actions := cronjobTwo.AdminActions()
+	paused, err := actions["pause"](context.Background(), nil)
+	if err != nil || paused != true || !cronjobTwo.Paused() {
+		t.Errorf("pause error: paused: %v err: %v", paused, err)
+	}
+	// tasks are skipped without sending
+	if err := cronjobTwo.jobWrapper(&CronJobTask{TaskSignature: &tasks.Signature{Name: "add"}})(); err != nil {
+		t.Errorf("paused job error: %v", err)
+	}
+	paused, err = actions["resume"](context.Background(), nil)
+	if err != nil || paused != false || cronjobTwo.Paused() {
+		t.Errorf("resume error: paused: %v err: %v", paused, err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/shanbay/gobay/observability"
 	"go.elastic.co/apm/module/apmsql"
 	_ "go.elastic.co/apm/module/apmsql/mysql"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -78,6 +79,13 @@ func (d *EntExt) Init(app *gobay.Application) error {
 	if config.IsSet("conn_max_lifetime") {
 		db.SetConnMaxLifetime(config.GetDuration("conn_max_lifetime"))
 	}
+	// report the connection pool stats, e.g. db_sql_connection_open
+	if err := otelsql.RegisterDBStatsMetrics(db,
+		otelsql.WithMeterProvider(app.MeterProvider()),
+		otelsql.WithAttributes(attribute.String("ns", d.NS)),
+	); err != nil {
+		return err
+	}
 	drv := entsql.OpenDB(dbDriver, db)
 	d.drv = drv
 	d.client = d.NewClient(d.Driver(drv))
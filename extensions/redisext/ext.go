@@ -12,6 +12,8 @@ import (
 	"github.com/shanbay/gobay"
 	"github.com/shanbay/gobay/observability"
 	"go.elastic.co/apm/module/apmgoredis"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // RedisExt redis扩展，处理client的初始化工作
@@ -22,8 +24,11 @@ type RedisExt struct {
 	redisclient    *redis.Client
 	apmable        bool
 	apmredisclient apmgoredis.Client
+	poolMetrics    metric.Registration
 }
 
+const meterName = "github.com/shanbay/gobay/extensions/redisext"
+
 var _ gobay.Extension = (*RedisExt)(nil)
 
 func (c *RedisExt) Init(app *gobay.Application) error {
@@ -42,10 +47,48 @@ func (c *RedisExt) Init(app *gobay.Application) error {
 		c.apmable = true
 		c.apmredisclient = apmgoredis.Wrap(c.redisclient)
 	}
-	_, err := c.redisclient.Ping().Result()
+	var err error
+	if c.poolMetrics, err = c.registerPoolMetrics(app.Meter(meterName)); err != nil {
+		return err
+	}
+	_, err = c.redisclient.Ping().Result()
 	return err
 }
 
+// registerPoolMetrics reports the connection pool stats of the client
+func (c *RedisExt) registerPoolMetrics(meter metric.Meter) (metric.Registration, error) {
+	hits, err := meter.Int64ObservableCounter("redis_pool_hits",
+		metric.WithDescription("Number of times a free connection was found in the pool"))
+	if err != nil {
+		return nil, err
+	}
+	misses, err := meter.Int64ObservableCounter("redis_pool_misses",
+		metric.WithDescription("Number of times a free connection was not found in the pool"))
+	if err != nil {
+		return nil, err
+	}
+	timeouts, err := meter.Int64ObservableCounter("redis_pool_timeouts",
+		metric.WithDescription("Number of times a wait timeout occurred"))
+	if err != nil {
+		return nil, err
+	}
+	conns, err := meter.Int64ObservableGauge("redis_pool_connections",
+		metric.WithDescription("Number of connections in the pool by state"))
+	if err != nil {
+		return nil, err
+	}
+	ns := attribute.String("ns", c.NS)
+	return meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := c.redisclient.PoolStats()
+		o.ObserveInt64(hits, int64(stats.Hits), metric.WithAttributes(ns))
+		o.ObserveInt64(misses, int64(stats.Misses), metric.WithAttributes(ns))
+		o.ObserveInt64(timeouts, int64(stats.Timeouts), metric.WithAttributes(ns))
+		o.ObserveInt64(conns, int64(stats.IdleConns), metric.WithAttributes(ns, attribute.String("state", "idle")))
+		o.ObserveInt64(conns, int64(stats.TotalConns-stats.IdleConns), metric.WithAttributes(ns, attribute.String("state", "used")))
+		return nil
+	}, hits, misses, timeouts, conns)
+}
+
 func (c *RedisExt) CheckHealth(ctx context.Context) error {
 	_, err := c.redisclient.Ping().Result()
 	if err != nil {
@@ -87,6 +130,9 @@ func (c *RedisExt) AddPrefix(key string) string {
 
 // Close close redis client
 func (c *RedisExt) Close() error {
+	if c.poolMetrics != nil {
+		_ = c.poolMetrics.Unregister()
+	}
 	return c.redisclient.Close()
 }
 
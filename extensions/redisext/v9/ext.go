@@ -14,6 +14,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/shanbay/gobay"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // RedisExt redis扩展，处理client的初始化工作
@@ -22,6 +23,8 @@ type RedisExt struct {
 	app         *gobay.Application
 	prefix      string
 	redisClient *redis.Client
+	// closed to stop reporting pool metrics
+	metricsDone chan struct{}
 }
 
 var _ gobay.Extension = (*RedisExt)(nil)
@@ -44,6 +47,14 @@ func (c *RedisExt) Init(app *gobay.Application) error {
 			return err
 		}
 	}
+	c.metricsDone = make(chan struct{})
+	if err := redisotel.InstrumentMetrics(c.redisClient,
+		redisotel.WithMeterProvider(app.MeterProvider()),
+		redisotel.WithAttributes(attribute.String("ns", c.NS)),
+		redisotel.WithCloseChan(c.metricsDone),
+	); err != nil {
+		return err
+	}
 	_, err := c.redisClient.Ping(context.Background()).Result()
 	return err
 }
@@ -89,6 +100,7 @@ func (c *RedisExt) AddPrefix(key string) string {
 
 // Close close redis client
 func (c *RedisExt) Close() error {
+	close(c.metricsDone)
 	return c.redisClient.Close()
 }
 
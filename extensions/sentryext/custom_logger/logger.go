@@ -2,6 +2,7 @@ package custom_logger
 
 import (
 	"log"
+	"log/slog"
 	"os"
 
 	"github.com/RichardKnop/logging"
@@ -24,6 +25,14 @@ func NewSentryErrorLogger() *sentryErrorLogger {
 	}
 }
 
+// NewSentryErrorLoggerWithSlog returns a logger which writes to logger at
+// error level, e.g. the logger of the app, instead of stderr
+func NewSentryErrorLoggerWithSlog(logger *slog.Logger) *sentryErrorLogger {
+	return &sentryErrorLogger{
+		slog.NewLogLogger(logger.Handler(), slog.LevelError),
+	}
+}
+
 func (s *sentryErrorLogger) captureOriginException(v ...interface{}) {
 	for _, vv := range v {
 		if err, ok := vv.(error); ok {
@@ -2,7 +2,9 @@ package custom_logger
 
 import (
 	"bytes"
+	"errors"
 	"github.com/stretchr/testify/assert"
+	"log/slog"
 	"testing"
 	"time"
 )
@@ -21,3 +23,13 @@ func Test_logger(t *testing.T) {
 	assert.Containsf(logedMsg, str1, "error message %s", "formatted")
 	assert.Containsf(logedMsg, now, "error message %s", "formatted")
 }
+
+func Test_slogLogger(t *testing.T) {
+	assert := assert.New(t)
+	var buf bytes.Buffer
+	logger := NewSentryErrorLoggerWithSlog(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	logger.Printf("push failed: %v", errors.New("timeout"))
+	assert.Contains(buf.String(), `"level":"ERROR"`)
+	assert.Contains(buf.String(), `"msg":"push failed: timeout"`)
+}
@@ -1,6 +1,7 @@
 package sentryext
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -42,12 +43,26 @@ func (d *SentryExt) Init(app *gobay.Application) error {
 	return nil
 }
 
+const defaultFlushTimeout = 5 * time.Second
+
+var _ gobay.BeforeCloser = (*SentryExt)(nil)
+
+// BeforeClose implements gobay.BeforeCloser interface
+func (d *SentryExt) BeforeClose(ctx context.Context) error {
+	// 在其他 extension 关闭前调用 Flush 方法保证所有 event 都被发送
+	if d.app == nil {
+		return nil
+	}
+	timeout := defaultFlushTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	sentry.Flush(timeout)
+	return nil
+}
+
 // Close implements Extension interface
 func (d *SentryExt) Close() error {
-	// 关闭前调用 Flush 方法保证所有 event 都被发送
-	if d.app != nil {
-		sentry.Flush(5 * time.Second)
-	}
 	return nil
 }
 
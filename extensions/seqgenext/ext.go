@@ -59,9 +59,24 @@ func (d *SequenceGeneratorExt) Init(app *gobay.Application) error {
 	d.app = app
 	d.SequenceBase = config.GetUint64("sequence_base")
 	d.SequenceKey = config.GetString("sequence_key")
+	if d.RedisExtName != "" {
+		redis, ok := app.Get(d.RedisExtName).Object().(ISeqRedis)
+		if !ok {
+			return fmt.Errorf("extension %s is not a ISeqRedis", d.RedisExtName)
+		}
+		d.redis = redis
+	}
 	return nil
 }
 
+// DependsOn implements Dependent interface
+func (d *SequenceGeneratorExt) DependsOn() []gobay.Key {
+	if d.RedisExtName == "" {
+		return nil
+	}
+	return []gobay.Key{d.RedisExtName}
+}
+
 // Object implements Extension interface
 func (d *SequenceGeneratorExt) Object() interface{} {
 	return d
@@ -84,7 +99,7 @@ func (g *SequenceGeneratorExt) getSequence(ctx context.Context, step uint64) (ui
 		return 0, fmt.Errorf("step should not less than 1 or greater than MAX_STEP(%d)", maxStep)
 	}
 	if g.redis == nil {
-		g.redis = g.app.Get(g.RedisExtName).Object().(ISeqRedis)
+		return 0, errors.New("lack of redis extension")
 	}
 	result, err := g.redis.EvalLua(ctx, luaScript, []string{g.SequenceKey}, maxSequence, step)
 	if err != nil {
@@ -3,9 +3,10 @@ package stubext
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
 	"net"
 	"strconv"
+	"sync"
 	"time"
 
 	"go.elastic.co/apm/module/apmgrpc"
@@ -14,6 +15,7 @@ import (
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/shanbay/gobay"
 	"github.com/shanbay/gobay/observability"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -33,6 +35,21 @@ var (
 	ErrUnHealthyUpStream = errors.New("grpc response body is no healthy upstream")
 )
 
+// Config the config of StubExt, keys are prefixed with NS
+type Config struct {
+	Host         string `validate:"required"`
+	Port         uint16 `validate:"required"`
+	ConnTimeout  time.Duration
+	CallTimeout  time.Duration
+	RetryBackoff time.Duration
+	RetryTimes   uint
+	Authority    string
+	Metadata     map[string]string
+	Mocked       bool
+}
+
+var _ gobay.Configurable = (*StubExt)(nil)
+
 type StubExt struct {
 	NS             string
 	DialOptions    []grpc.DialOption
@@ -50,13 +67,21 @@ type StubExt struct {
 	Metadata     map[string]string
 	Mocked       bool
 
-	Clients map[string]interface{}
-	conn    *grpc.ClientConn
-	apmable bool
+	Clients  map[string]interface{}
+	conn     *grpc.ClientConn
+	apmable  bool
+	otelable bool
+	mu       sync.RWMutex
+	callOpts []grpc_retry.CallOption
+	logger   *slog.Logger
+	metrics  *clientMetrics
 }
 
 func (d *StubExt) Application() *gobay.Application { return d.app }
 
+// SetLogger implements gobay.LoggerSetter
+func (d *StubExt) SetLogger(logger *slog.Logger) { d.logger = logger }
+
 func (d *StubExt) Object() interface{} { return d }
 
 func (d *StubExt) Close() error {
@@ -114,17 +139,28 @@ func (d *StubExt) Init(app *gobay.Application) error {
 
 	// init from config
 	d.app = app
+	if d.logger == nil {
+		d.logger = app.Logger()
+	}
 	config := app.Config()
 	d.apmable = observability.GetApmEnable()
+	d.otelable = observability.GetOtelEnable()
 	config = gobay.GetConfigByPrefix(config, d.NS, true)
 	if err := config.Unmarshal(d); err != nil {
 		return err
 	}
 	if d.Port == 0 || d.Host == "" {
-		log.Printf("host: %v, port: %v", d.Host, d.Port)
+		d.logger.Error("lack of port or host", "host", d.Host, "port", d.Port)
 		return errors.New("lack of port or host")
 	}
 
+	d.callOpts = d.getCallOpts()
+	metrics, err := newClientMetrics(app.Meter(meterName), d.NS)
+	if err != nil {
+		return err
+	}
+	d.metrics = metrics
+
 	// init connection and client
 	if d.Mocked {
 		return nil
@@ -139,6 +175,57 @@ func (d *StubExt) Init(app *gobay.Application) error {
 	return nil
 }
 
+// ConfigSchema implements gobay.Configurable interface
+func (d *StubExt) ConfigSchema() (string, interface{}) {
+	return d.NS, &Config{}
+}
+
+// OnConfigChange implements gobay.ConfigWatcher interface. The new call
+// timeout and retry settings apply to subsequent calls, changes of host, port
+// and other connection settings take effect after restart.
+func (d *StubExt) OnConfigChange(_, new *viper.Viper) error {
+	config := gobay.GetConfigByPrefix(new, d.NS, true)
+	opts := struct {
+		CallTimeout  time.Duration
+		RetryBackoff time.Duration
+		RetryTimes   uint
+	}{defaultCallTimeout, defaultRetryBackoff, uint(defaultRetryTimes)}
+	if err := config.Unmarshal(&opts); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.CallTimeout = opts.CallTimeout
+	d.RetryBackoff = opts.RetryBackoff
+	d.RetryTimes = opts.RetryTimes
+	d.callOpts = d.getCallOpts()
+	return nil
+}
+
+func (d *StubExt) currentCallOpts() []grpc.CallOption {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	opts := make([]grpc.CallOption, len(d.callOpts))
+	for i, opt := range d.callOpts {
+		opts[i] = opt
+	}
+	return opts
+}
+
+// callOpts的unary拦截器，per call options 优先于当前配置
+func (d *StubExt) newCallOptsUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(ctx, method, req, reply, cc, append(d.currentCallOpts(), opts...)...)
+	}
+}
+
+// callOpts的stream拦截器，per call options 优先于当前配置
+func (d *StubExt) newCallOptsStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(ctx, desc, cc, method, append(d.currentCallOpts(), opts...)...)
+	}
+}
+
 func (d *StubExt) getCallOpts() []grpc_retry.CallOption {
 	// per call timeout
 	var callOpts []grpc_retry.CallOption
@@ -172,17 +259,37 @@ func (d *StubExt) GetConn(userOpts ...grpc.DialOption) (*grpc.ClientConn, error)
 		if d.apmable {
 			opts = append(opts, grpc.WithChainUnaryInterceptor(apmgrpc.NewUnaryClientInterceptor()))
 		}
+		// opts: spans of the calls and attempts
+		if d.otelable {
+			opts = append(
+				opts,
+				grpc.WithStatsHandler(d.statsHandler()),
+				grpc.WithChainUnaryInterceptor(d.callSpanUnaryInterceptor()),
+			)
+		}
+		// opts: metrics of the whole call including retries
+		if d.metrics != nil {
+			opts = append(
+				opts,
+				grpc.WithChainUnaryInterceptor(d.metrics.unaryInterceptor()),
+				grpc.WithChainStreamInterceptor(d.metrics.streamInterceptor()),
+			)
+		}
 		// opts: user opts
 		opts = append(opts, userOpts...)
-		// opts: per call opts
-		callOpts := d.getCallOpts()
+		// opts: per call opts, read on every call so that they can be reloaded
 		opts = append(
 			opts,
-			grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(callOpts...)),
+			grpc.WithChainUnaryInterceptor(d.newCallOptsUnaryInterceptor()),
+			grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor()),
 			grpc.WithChainUnaryInterceptor(newUHUnaryInterceptor()),
-			grpc.WithChainStreamInterceptor(grpc_retry.StreamClientInterceptor(callOpts...)),
+			grpc.WithChainStreamInterceptor(d.newCallOptsStreamInterceptor()),
+			grpc.WithChainStreamInterceptor(grpc_retry.StreamClientInterceptor()),
 			grpc.WithChainStreamInterceptor(newUHStreamInterceptor()),
 		)
+		if d.otelable {
+			opts = append(opts, grpc.WithChainUnaryInterceptor(retryEventUnaryInterceptor()))
+		}
 		// connect
 		ctxDefault := context.Background()
 		if d.ConnTimeout > 0 {
@@ -5,19 +5,29 @@ import (
 	"errors"
 	"log"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/shanbay/gobay"
 	mock_protos_go "github.com/shanbay/gobay/testdata/health_pb_mock"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -241,3 +251,70 @@ func TestStubExtServerStopMocked(t *testing.T) {
 	client := stubext.Clients["health"]
 	assert.Nil(t, client)
 }
+
+func TestStubExtOnConfigChange(t *testing.T) {
+	setupStub("grpcmocked")
+	assert.Equal(t, uint(3), stubext.RetryTimes)
+
+	config := viper.New()
+	config.Set("stub_health_calltimeout", "1s")
+	config.Set("stub_health_retrytimes", 0)
+	assert.Nil(t, stubext.OnConfigChange(stubext.Application().Config(), config))
+	assert.Equal(t, 1*time.Second, stubext.CallTimeout)
+	assert.Equal(t, defaultRetryBackoff, stubext.RetryBackoff)
+	assert.Equal(t, uint(0), stubext.RetryTimes)
+	assert.Len(t, stubext.currentCallOpts(), 3)
+}
+
+func TestStubExtMetrics(t *testing.T) {
+	setupServer()
+	defer tearDownServer()
+	setupStub("testing")
+
+	stubclient := stubext.Clients["health"].(grpc_health_v1.HealthClient)
+	_, err := stubclient.Check(stubext.GetCtx(context.Background()), &grpc_health_v1.HealthCheckRequest{})
+	assert.Nil(t, err)
+
+	rec := httptest.NewRecorder()
+	stubext.Application().MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, rec.Body.String(),
+		`grpc_client_handled{grpc_code="OK",grpc_method="Check",grpc_service="grpc.health.v1.Health",grpc_type="unary",ns="stub_health_"} 1`)
+	assert.Contains(t, rec.Body.String(), `grpc_client_handling_seconds_count{`)
+}
+
+func TestStubExtTracing(t *testing.T) {
+	t.Setenv("OTEL_ENABLE", "true")
+	t.Setenv("OTEL_TRACES_EXPORTER", "none")
+	setupServer()
+	setupStub("testing")
+	recorder := tracetest.NewSpanRecorder()
+	otel.GetTracerProvider().(*sdktrace.TracerProvider).RegisterSpanProcessor(recorder)
+
+	stubclient := stubext.Clients["health"].(grpc_health_v1.HealthClient)
+	_, err := stubclient.Check(stubext.GetCtx(context.Background()), &grpc_health_v1.HealthCheckRequest{})
+	assert.Nil(t, err)
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	attempt, call := spans[0], spans[1]
+	assert.Equal(t, "grpc.health.v1.Health/Check", call.Name())
+	assert.Equal(t, "grpc.health.v1.Health/Check", attempt.Name())
+	assert.Equal(t, trace.SpanKindClient, attempt.SpanKind())
+	assert.Equal(t, call.SpanContext().SpanID(), attempt.Parent().SpanID())
+	assert.Contains(t, call.Attributes(), semconv.RPCGRPCStatusCodeKey.Int(0))
+	assert.Contains(t, call.Attributes(), semconv.ServerAddress("127.0.0.1"))
+	assert.Empty(t, call.Events())
+
+	// 3 attempts after the server stops
+	tearDownServer()
+	_, err = stubclient.Check(stubext.GetCtx(context.Background()), &grpc_health_v1.HealthCheckRequest{})
+	assert.NotNil(t, err)
+	spans = recorder.Ended()[2:]
+	assert.Len(t, spans, 4)
+	call = spans[3]
+	assert.Equal(t, otelcodes.Error, call.Status().Code)
+	assert.Contains(t, call.Attributes(), semconv.RPCGRPCStatusCodeKey.Int(int(codes.Unavailable)))
+	assert.Len(t, call.Events(), 2)
+	for _, attempt := range spans[:3] {
+		assert.Equal(t, call.SpanContext().SpanID(), attempt.Parent().SpanID())
+	}
+}
@@ -0,0 +1,121 @@
+package stubext
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const meterName = "github.com/shanbay/gobay/extensions/stubext"
+
+// clientMetrics records the outgoing calls of a StubExt, named like the
+// client metrics of grpc_prometheus. A call is recorded once with its final
+// status, including the retries.
+type clientMetrics struct {
+	ns       attribute.KeyValue
+	handled  metric.Int64Counter
+	handling metric.Float64Histogram
+}
+
+func newClientMetrics(meter metric.Meter, ns string) (*clientMetrics, error) {
+	m := &clientMetrics{ns: attribute.String("ns", ns)}
+	var err error
+	if m.handled, err = meter.Int64Counter("grpc_client_handled",
+		metric.WithDescription("Number of RPCs completed by the client by status code")); err != nil {
+		return nil, err
+	}
+	if m.handling, err = meter.Float64Histogram("grpc_client_handling",
+		metric.WithDescription("Duration of RPCs until the response is received"),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *clientMetrics) record(ctx context.Context, grpcType, fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	attrs := metric.WithAttributes(
+		m.ns,
+		attribute.String("grpc_type", grpcType),
+		attribute.String("grpc_service", service),
+		attribute.String("grpc_method", method),
+		attribute.String("grpc_code", status.Code(err).String()),
+	)
+	m.handled.Add(ctx, 1, attrs)
+	m.handling.Record(ctx, time.Since(start).Seconds(), attrs)
+}
+
+func (m *clientMetrics) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		m.record(ctx, "unary", method, start, err)
+		return err
+	}
+}
+
+func (m *clientMetrics) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		grpcType := streamType(desc)
+		clientStream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			m.record(ctx, grpcType, method, start, err)
+			return nil, err
+		}
+		return &monitoredClientStream{ClientStream: clientStream, done: func(err error) {
+			m.record(ctx, grpcType, method, start, err)
+		}}, nil
+	}
+}
+
+// monitoredClientStream reports the end of the stream, which is the first
+// error returned by RecvMsg, io.EOF if the stream finished successfully
+type monitoredClientStream struct {
+	grpc.ClientStream
+	once sync.Once
+	done func(error)
+}
+
+func (s *monitoredClientStream) RecvMsg(msg interface{}) error {
+	err := s.ClientStream.RecvMsg(msg)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				s.done(nil)
+			} else {
+				s.done(err)
+			}
+		})
+	}
+	return err
+}
+
+func streamType(desc *grpc.StreamDesc) string {
+	switch {
+	case desc.ClientStreams && desc.ServerStreams:
+		return "bidi_stream"
+	case desc.ClientStreams:
+		return "client_stream"
+	case desc.ServerStreams:
+		return "server_stream"
+	}
+	return "unary"
+}
+
+// splitMethod splits /package.Service/Method into the service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
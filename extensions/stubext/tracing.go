@@ -0,0 +1,85 @@
+package stubext
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric/noop"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+// The outgoing calls are traced by OpenTelemetry if it is enabled. Every
+// attempt of a call is a client span created by the otelgrpc stats handler,
+// which also propagates the trace to the server. The attempts of an unary
+// call are children of a span of the whole call, with an event for every
+// retry.
+
+// spanAttributes are the attributes of all the spans of d
+func (d *StubExt) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("ns", d.NS),
+		semconv.ServerAddress(d.Host),
+		semconv.ServerPort(int(d.Port)),
+	}
+}
+
+// statsHandler creates a span for every attempt of a call
+func (d *StubExt) statsHandler() stats.Handler {
+	return otelgrpc.NewClientHandler(
+		// calls are recorded by clientMetrics
+		otelgrpc.WithMeterProvider(noop.NewMeterProvider()),
+		otelgrpc.WithSpanAttributes(d.spanAttributes()...),
+	)
+}
+
+// callSpanUnaryInterceptor creates a span of the whole call including the
+// retries, it must be chained before the retry interceptor
+func (d *StubExt) callSpanUnaryInterceptor() grpc.UnaryClientInterceptor {
+	tracer := otel.Tracer(meterName)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		service, name := splitMethod(method)
+		attrs := append(d.spanAttributes(),
+			semconv.RPCSystemGRPC,
+			semconv.RPCService(service),
+			semconv.RPCMethod(name),
+		)
+		ctx, span := tracer.Start(ctx, strings.TrimPrefix(method, "/"),
+			trace.WithSpanKind(trace.SpanKindInternal),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		s := status.Convert(err)
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(s.Code())))
+		if err != nil {
+			span.SetStatus(otelcodes.Error, s.Message())
+		}
+		return err
+	}
+}
+
+// retryEventUnaryInterceptor adds an event to the call span for every retry,
+// it must be chained after the retry interceptor
+func retryEventUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if values := md.Get(grpc_retry.AttemptMetadataKey); len(values) > 0 {
+			attempt, _ := strconv.Atoi(values[0])
+			trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+				attribute.Int("rpc.retry.attempt", attempt),
+			))
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
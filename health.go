@@ -0,0 +1,252 @@
+package gobay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHealthCheckTimeout = "3s"
+	defaultHealthCacheTTL     = "1s"
+
+	// service names of the grpc health server, besides the extension keys
+	livenessService  = "liveness"
+	readinessService = "readiness"
+)
+
+// HealthChecker is implemented by extensions which can check the health of
+// their dependencies, e.g. redis or database connections. The checks decide
+// the readiness of the app.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+// LivenessChecker is implemented by extensions which can tell that the
+// process is broken and needs to be restarted, e.g. a dead worker loop. The
+// checks decide the liveness of the app.
+type LivenessChecker interface {
+	CheckLiveness(ctx context.Context) error
+}
+
+// CheckResult is the result of the health check of an extension
+type CheckResult struct {
+	Healthy  bool          `json:"healthy"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// HealthReport is the result of the liveness or readiness check of an app
+type HealthReport struct {
+	Healthy   bool                `json:"healthy"`
+	Checks    map[Key]CheckResult `json:"checks"`
+	CheckedAt time.Time           `json:"checked_at"`
+}
+
+// healthCache caches the last report for health_cache_ttl, concurrent
+// callers wait for the running check instead of starting another one
+type healthCache struct {
+	mu     sync.Mutex
+	report *HealthReport
+}
+
+// CheckLiveness runs CheckLiveness of extensions. The app is alive if all
+// of them pass.
+func (d *Application) CheckLiveness(ctx context.Context) HealthReport {
+	return d.liveness.get(ctx, d, func(ctx context.Context) HealthReport {
+		checks := make(map[Key]func(context.Context) error)
+		for key, ext := range d.extensions {
+			if checker, ok := ext.(LivenessChecker); ok {
+				checks[key] = checker.CheckLiveness
+			}
+		}
+		return d.runHealthChecks(ctx, checks)
+	})
+}
+
+// CheckReadiness runs CheckHealth of extensions. The app is ready if it is
+// initialized, not closing and all of them pass.
+func (d *Application) CheckReadiness(ctx context.Context) HealthReport {
+	if !d.running.Load() {
+		return HealthReport{Checks: map[Key]CheckResult{}, CheckedAt: time.Now()}
+	}
+	return d.readiness.get(ctx, d, func(ctx context.Context) HealthReport {
+		checks := make(map[Key]func(context.Context) error)
+		for key, ext := range d.extensions {
+			if checker, ok := ext.(HealthChecker); ok {
+				checks[key] = checker.CheckHealth
+			}
+		}
+		return d.runHealthChecks(ctx, checks)
+	})
+}
+
+func (c *healthCache) get(ctx context.Context, app *Application, check func(context.Context) HealthReport) HealthReport {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ttl := app.Config().GetDuration("health_cache_ttl")
+	if c.report != nil && time.Since(c.report.CheckedAt) < ttl {
+		return *c.report
+	}
+	// the report is shared, don't let a canceled caller fail it
+	report := check(context.WithoutCancel(ctx))
+	c.report = &report
+	return report
+}
+
+// runHealthChecks runs checks concurrently, each one is bounded by
+// health_check_timeout
+func (d *Application) runHealthChecks(ctx context.Context, checks map[Key]func(context.Context) error) HealthReport {
+	timeout := d.Config().GetDuration("health_check_timeout")
+	report := HealthReport{Healthy: true, Checks: make(map[Key]CheckResult, len(checks))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for key, check := range checks {
+		wg.Add(1)
+		go func(key Key, check func(context.Context) error) {
+			defer wg.Done()
+			start := time.Now()
+			err := runHealthCheck(ctx, check, timeout)
+			result := CheckResult{Healthy: err == nil, Duration: time.Since(start)}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[key] = result
+			if err != nil {
+				report.Healthy = false
+			}
+		}(key, check)
+	}
+	wg.Wait()
+	report.CheckedAt = time.Now()
+	return report
+}
+
+// runHealthCheck gives up waiting for check after timeout if timeout > 0,
+// even if check ignores the context
+func runHealthCheck(ctx context.Context, check func(context.Context) error, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	result := make(chan error, 1)
+	go func() {
+		result <- check(ctx)
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("health check: %w", ctx.Err())
+	}
+}
+
+// LivenessHandler serves the liveness report as JSON, the status code is
+// 200 if the app is alive, otherwise 503
+func (d *Application) LivenessHandler() http.Handler {
+	return healthHandler(d.CheckLiveness)
+}
+
+// ReadinessHandler serves the readiness report as JSON, the status code is
+// 200 if the app is ready, otherwise 503
+func (d *Application) ReadinessHandler() http.Handler {
+	return healthHandler(d.CheckReadiness)
+}
+
+func healthHandler(check func(context.Context) HealthReport) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := check(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if report.Healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+// HealthServer returns a grpc health server backed by the health checks of
+// the app. The service "liveness" reports the liveness, "" and "readiness"
+// report the readiness, and an extension key reports the health check of
+// that extension.
+func (d *Application) HealthServer() grpc_health_v1.HealthServer {
+	return &healthServer{app: d}
+}
+
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	app *Application
+}
+
+func (s *healthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	healthy, err := s.check(ctx, req.Service)
+	if err != nil {
+		return nil, err
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus(healthy)}, nil
+}
+
+// Watch sends the status when it changes, checking every health_cache_ttl
+func (s *healthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ctx := stream.Context()
+	interval := s.app.Config().GetDuration("health_cache_ttl")
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		current := grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		if healthy, err := s.check(ctx, req.Service); err == nil {
+			current = servingStatus(healthy)
+		}
+		if current != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *healthServer) check(ctx context.Context, service string) (bool, error) {
+	switch service {
+	case livenessService:
+		return s.app.CheckLiveness(ctx).Healthy, nil
+	case "", readinessService:
+		return s.app.CheckReadiness(ctx).Healthy, nil
+	}
+	if _, ok := s.app.extensions[Key(service)]; !ok {
+		return false, status.Errorf(codes.NotFound, "unknown service %s", service)
+	}
+	report := s.app.CheckReadiness(ctx)
+	if result, ok := report.Checks[Key(service)]; ok {
+		return result.Healthy, nil
+	}
+	// extensions without health checks follow the app
+	return report.Healthy, nil
+}
+
+func servingStatus(healthy bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if healthy {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,134 @@
+package gobay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type healthExtension struct {
+	orderExtension
+	err   error
+	block bool
+	calls atomic.Int32
+}
+
+func (e *healthExtension) CheckHealth(ctx context.Context) error {
+	e.calls.Add(1)
+	if e.block {
+		select {}
+	}
+	return e.err
+}
+
+type livenessExtension struct {
+	orderExtension
+	err error
+}
+
+func (e *livenessExtension) CheckLiveness(ctx context.Context) error { return e.err }
+
+func newHealthApp(t *testing.T, exts map[Key]Extension) *Application {
+	app, err := CreateAppFromMap(map[string]interface{}{
+		"health_check_timeout": "50ms",
+		"health_cache_ttl":     "1h",
+	}, exts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return app
+}
+
+func TestCheckReadiness(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	healthy := &healthExtension{orderExtension: orderExtension{key: "healthy", events: &events}}
+	broken := &healthExtension{orderExtension: orderExtension{key: "broken", events: &events}, err: errors.New("broken")}
+	blocked := &healthExtension{orderExtension: orderExtension{key: "blocked", events: &events}, block: true}
+	app := newHealthApp(t, map[Key]Extension{"healthy": healthy, "broken": broken, "blocked": blocked})
+
+	start := time.Now()
+	report := app.CheckReadiness(context.Background())
+	// checks run concurrently and are bounded by health_check_timeout
+	assert.Less(time.Since(start), time.Second)
+	assert.False(report.Healthy)
+	assert.True(report.Checks["healthy"].Healthy)
+	assert.Equal("broken", report.Checks["broken"].Error)
+	assert.Equal("health check: context deadline exceeded", report.Checks["blocked"].Error)
+
+	// cached
+	app.CheckReadiness(context.Background())
+	assert.Equal(int32(1), healthy.calls.Load())
+
+	// liveness only runs liveness checks
+	assert.True(app.CheckLiveness(context.Background()).Healthy)
+
+	// not ready after close
+	assert.Nil(app.Close())
+	assert.False(app.CheckReadiness(context.Background()).Healthy)
+}
+
+func TestCheckLiveness(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	dead := &livenessExtension{orderExtension: orderExtension{key: "dead", events: &events}, err: errors.New("dead")}
+	app := newHealthApp(t, map[Key]Extension{"dead": dead})
+	report := app.CheckLiveness(context.Background())
+	assert.False(report.Healthy)
+	assert.Equal("dead", report.Checks["dead"].Error)
+	assert.True(app.CheckReadiness(context.Background()).Healthy)
+}
+
+func TestHealthHandlers(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	broken := &healthExtension{orderExtension: orderExtension{key: "broken", events: &events}, err: errors.New("broken")}
+	app := newHealthApp(t, map[Key]Extension{"broken": broken})
+
+	rec := httptest.NewRecorder()
+	app.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(http.StatusServiceUnavailable, rec.Code)
+	var report HealthReport
+	assert.Nil(json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.False(report.Healthy)
+	assert.Equal("broken", report.Checks["broken"].Error)
+
+	rec = httptest.NewRecorder()
+	app.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(http.StatusOK, rec.Code)
+}
+
+func TestHealthServer(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	healthy := &healthExtension{orderExtension: orderExtension{key: "healthy", events: &events}}
+	broken := &healthExtension{orderExtension: orderExtension{key: "broken", events: &events}, err: errors.New("broken")}
+	plain := &orderExtension{key: "plain", events: &events}
+	app := newHealthApp(t, map[Key]Extension{"healthy": healthy, "broken": broken, "plain": plain})
+	server := app.HealthServer()
+
+	for service, expected := range map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+		"":          grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		"readiness": grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		"liveness":  grpc_health_v1.HealthCheckResponse_SERVING,
+		"healthy":   grpc_health_v1.HealthCheckResponse_SERVING,
+		"broken":    grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		"plain":     grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+	} {
+		resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		assert.Nil(err)
+		assert.Equal(expected, resp.Status, service)
+	}
+	_, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(codes.NotFound, status.Code(err))
+}
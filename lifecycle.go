@@ -0,0 +1,144 @@
+package gobay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	defaultInitTimeout      = "60s"
+	defaultLifecycleTimeout = "30s"
+)
+
+// AfterIniter is implemented by extensions which need a second init phase
+// after all extensions are initialized, e.g. registering consumers which use
+// other extensions.
+type AfterIniter interface {
+	AfterInit(ctx context.Context) error
+}
+
+// Starter is implemented by extensions which do some work when the app
+// starts serving, e.g. warming caches.
+type Starter interface {
+	OnStart(ctx context.Context) error
+}
+
+// BeforeCloser is implemented by extensions which need to do some work
+// before any extension is closed, e.g. flushing buffered events.
+type BeforeCloser interface {
+	BeforeClose(ctx context.Context) error
+}
+
+// A Hook is a user callback called in the app lifecycle
+type Hook func(ctx context.Context) error
+
+// OnStart registers fn to be called in Start after the extension hooks,
+// in the order of registration.
+func (d *Application) OnStart(fn Hook) {
+	d.hooksMu.Lock()
+	defer d.hooksMu.Unlock()
+	d.startHooks = append(d.startHooks, fn)
+}
+
+// OnClose registers fn to be called in Close before the extension hooks,
+// in the reverse order of registration.
+func (d *Application) OnClose(fn Hook) {
+	d.hooksMu.Lock()
+	defer d.hooksMu.Unlock()
+	d.closeHooks = append(d.closeHooks, fn)
+}
+
+// Start calls OnStart of extensions in init order and then the callbacks
+// registered by OnStart, and starts the metrics server and the admin server
+// if their ports are set. Call it when the app is about to serve.
+func (d *Application) Start() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.initialized {
+		return errors.New("application is not initialized")
+	}
+	if d.started {
+		return nil
+	}
+	ctx, cancel := d.lifecycleContext(context.Background())
+	defer cancel()
+	for _, key := range d.order {
+		if starter, ok := d.extensions[key].(Starter); ok {
+			if err := starter.OnStart(ctx); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	for _, fn := range d.hooks(&d.startHooks) {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+	}
+	if err := d.startMetricsServer(); err != nil {
+		return fmt.Errorf("start metrics server failed: %w", err)
+	}
+	if err := d.startAdminServer(); err != nil {
+		return fmt.Errorf("start admin server failed: %w", err)
+	}
+	d.started = true
+	return nil
+}
+
+func (d *Application) afterInitExtensions() error {
+	ctx, cancel := d.lifecycleContext(context.Background())
+	defer cancel()
+	for _, key := range d.order {
+		if initer, ok := d.extensions[key].(AfterIniter); ok {
+			if err := initer.AfterInit(ctx); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// beforeClose calls the callbacks registered by OnClose and BeforeClose of
+// extensions, both in reverse order. All of them are called even if some
+// fail, and the errors are returned together.
+func (d *Application) beforeClose(ctx context.Context) error {
+	ctx, cancel := d.lifecycleContext(ctx)
+	defer cancel()
+	var allerr error
+	closeHooks := d.hooks(&d.closeHooks)
+	for i := len(closeHooks) - 1; i >= 0; i-- {
+		if err := closeHooks[i](ctx); err != nil {
+			allerr = multierror.Append(allerr, err)
+		}
+	}
+	for i := len(d.order) - 1; i >= 0; i-- {
+		key := d.order[i]
+		if closer, ok := d.extensions[key].(BeforeCloser); ok {
+			if err := closer.BeforeClose(ctx); err != nil {
+				allerr = multierror.Append(allerr, errors.New(string(key)), err)
+			}
+		}
+	}
+	return allerr
+}
+
+// hooks returns a copy of the callbacks, so that a callback can register
+// other callbacks
+func (d *Application) hooks(hooks *[]Hook) []Hook {
+	d.hooksMu.Lock()
+	defer d.hooksMu.Unlock()
+	return append([]Hook(nil), (*hooks)...)
+}
+
+// lifecycleContext returns a context with timeout lifecycle_timeout for
+// a lifecycle phase, 0 means no timeout
+func (d *Application) lifecycleContext(parent context.Context) (context.Context, context.CancelFunc) {
+	timeout := d.Config().GetDuration("lifecycle_timeout")
+	if timeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, timeout)
+}
@@ -0,0 +1,101 @@
+package gobay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hookExtension struct {
+	orderExtension
+	err error
+}
+
+func (e *hookExtension) AfterInit(ctx context.Context) error {
+	*e.events = append(*e.events, "after init "+string(e.key))
+	return e.err
+}
+
+func (e *hookExtension) OnStart(ctx context.Context) error {
+	*e.events = append(*e.events, "start "+string(e.key))
+	return nil
+}
+
+func (e *hookExtension) BeforeClose(ctx context.Context) error {
+	*e.events = append(*e.events, "before close "+string(e.key))
+	return nil
+}
+
+func TestLifecycleHooks(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	exts := map[Key]Extension{
+		"a": &hookExtension{orderExtension: orderExtension{key: "a", deps: []Key{"b"}, events: &events}},
+		"b": &hookExtension{orderExtension: orderExtension{key: "b", events: &events}},
+	}
+	app, err := CreateApp("./testdata", "testing", exts)
+	assert.Nil(err)
+	assert.Equal([]string{"init b", "init a", "after init b", "after init a"}, events)
+
+	events = nil
+	app.OnStart(func(ctx context.Context) error {
+		deadline, ok := ctx.Deadline()
+		assert.True(ok)
+		assert.WithinDuration(time.Now().Add(30*time.Second), deadline, time.Second)
+		events = append(events, "start callback")
+		return nil
+	})
+	app.OnClose(func(ctx context.Context) error {
+		events = append(events, "close callback 1")
+		return nil
+	})
+	app.OnClose(func(ctx context.Context) error {
+		events = append(events, "close callback 2")
+		return nil
+	})
+	assert.Nil(app.Start())
+	assert.Nil(app.Start())
+	assert.Equal([]string{"start b", "start a", "start callback"}, events)
+
+	events = nil
+	assert.Nil(app.Close())
+	assert.Equal([]string{
+		"close callback 2", "close callback 1",
+		"before close a", "before close b",
+		"close a", "close b",
+	}, events)
+}
+
+func TestLifecycleHookError(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	exts := map[Key]Extension{
+		"a": &hookExtension{orderExtension: orderExtension{key: "a", events: &events}, err: errors.New("warm up failed")},
+	}
+	app, err := CreateApp("./testdata", "testing", exts)
+	assert.Nil(app)
+	assert.EqualError(err, "a: warm up failed")
+
+	app, err = CreateApp("./testdata", "testing", map[Key]Extension{})
+	assert.Nil(err)
+	app.OnStart(func(ctx context.Context) error {
+		return errors.New("start failed")
+	})
+	assert.EqualError(app.Start(), "start failed")
+}
+
+func TestLifecycleNoTimeout(t *testing.T) {
+	assert := assert.New(t)
+	app, err := CreateAppFromMap(map[string]interface{}{"lifecycle_timeout": 0}, map[Key]Extension{})
+	assert.Nil(err)
+	app.OnStart(func(ctx context.Context) error {
+		_, ok := ctx.Deadline()
+		assert.False(ok)
+		return ctx.Err()
+	})
+	assert.Nil(app.Start())
+	assert.Nil(app.Close())
+}
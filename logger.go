@@ -0,0 +1,136 @@
+package gobay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultLogLevel  = "info"
+	defaultLogFormat = "text"
+	defaultLogOutput = "stderr"
+)
+
+// LoggerSetter is implemented by extensions which log through the logger of
+// the application. SetLogger is called before Init with a child logger which
+// has an ext attribute of the extension key.
+type LoggerSetter interface {
+	SetLogger(logger *slog.Logger)
+}
+
+// Logger returns the logger of the application, which is configured by
+// log_level, log_format and log_output. It is slog.Default() before Init.
+func (d *Application) Logger() *slog.Logger {
+	if d.logger == nil {
+		return slog.Default()
+	}
+	return d.logger
+}
+
+// ExtLogger returns the logger for the extension at key
+func (d *Application) ExtLogger(key Key) *slog.Logger {
+	return d.Logger().With("ext", string(key))
+}
+
+// setupLogger creates the logger from config:
+//
+//	log_level: debug, info, warn or error, can be changed by ReloadConfig
+//	log_format: text or json
+//	log_output: stdout, stderr or a file path
+func (d *Application) setupLogger() error {
+	config := d.Config()
+
+	level, err := logLevelOf(config)
+	if err != nil {
+		return err
+	}
+	d.logLevel.Set(level)
+
+	var output io.Writer
+	switch path := config.GetString("log_output"); path {
+	case "stdout":
+		output = os.Stdout
+	case "stderr":
+		output = os.Stderr
+	default:
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return fmt.Errorf("open log_output failed: %w", err)
+		}
+		d.logFile = f
+		output = f
+	}
+
+	opts := &slog.HandlerOptions{Level: &d.logLevel}
+	var handler slog.Handler
+	switch format := strings.ToLower(config.GetString("log_format")); format {
+	case "text":
+		handler = slog.NewTextHandler(output, opts)
+	case "json":
+		handler = slog.NewJSONHandler(output, opts)
+	default:
+		d.closeLogger()
+		return fmt.Errorf("invalid log_format: %s", format)
+	}
+	d.logger = slog.New(&traceHandler{handler})
+	return nil
+}
+
+// logLevelOf returns the log_level of config
+func logLevelOf(config *viper.Viper) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(config.GetString("log_level"))); err != nil {
+		return level, fmt.Errorf("invalid log_level: %w", err)
+	}
+	return level, nil
+}
+
+// reloadLogger applies the log_level of the new config, which is validated
+// by ReloadConfig, other log options only take effect after restarting
+func (d *Application) reloadLogger() {
+	if d.logger == nil {
+		return
+	}
+	if level, err := logLevelOf(d.Config()); err == nil {
+		d.logLevel.Set(level)
+	}
+}
+
+func (d *Application) closeLogger() error {
+	if d.logFile == nil {
+		return nil
+	}
+	err := d.logFile.Close()
+	d.logFile = nil
+	return err
+}
+
+// traceHandler adds trace_id and span_id of the span in the context
+type traceHandler struct {
+	slog.Handler
+}
+
+func (h *traceHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *traceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &traceHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h *traceHandler) WithGroup(name string) slog.Handler {
+	return &traceHandler{h.Handler.WithGroup(name)}
+}
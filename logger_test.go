@@ -0,0 +1,89 @@
+package gobay
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type loggerExtension struct {
+	orderExtension
+	logger *slog.Logger
+}
+
+func (e *loggerExtension) SetLogger(logger *slog.Logger) { e.logger = logger }
+
+func readLogs(t *testing.T, path string) []map[string]interface{} {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatal(err)
+		}
+		logs = append(logs, record)
+	}
+	return logs
+}
+
+func TestLogger(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	output := filepath.Join(dir, "app.log")
+	writeConfig(t, dir, "testing:\n  log_level: warn\n  log_format: json\n  log_output: "+output+"\n")
+	var events []string
+	ext := &loggerExtension{orderExtension: orderExtension{key: "ext", events: &events}}
+	app, err := CreateApp(dir, "testing", map[Key]Extension{"ext": ext})
+	assert.Nil(err)
+
+	ext.logger.Info("ignored")
+	ext.logger.Warn("from ext", "key", "value")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	}))
+	app.Logger().ErrorContext(ctx, "with trace")
+
+	// log_level can be reloaded
+	writeConfig(t, dir, "testing:\n  log_level: info\n  log_format: json\n  log_output: "+output+"\n")
+	_, err = app.ReloadConfig()
+	assert.Nil(err)
+	ext.logger.Info("after reload")
+
+	// an invalid log_level is rejected and the current config is kept
+	writeConfig(t, dir, "testing:\n  log_level: verbose\n  log_format: json\n  log_output: "+output+"\n")
+	_, err = app.ReloadConfig()
+	assert.ErrorContains(err, "invalid log_level")
+	assert.Equal("info", app.Config().GetString("log_level"))
+	ext.logger.Debug("ignored after rejected reload")
+	assert.Nil(app.Close())
+
+	logs := readLogs(t, output)
+	assert.Len(logs, 3)
+	assert.Equal("from ext", logs[0]["msg"])
+	assert.Equal("ext", logs[0]["ext"])
+	assert.Equal("value", logs[0]["key"])
+	assert.Equal("with trace", logs[1]["msg"])
+	assert.Equal("01000000000000000000000000000000", logs[1]["trace_id"])
+	assert.Equal("0200000000000000", logs[1]["span_id"])
+	assert.Nil(logs[1]["ext"])
+	assert.Equal("after reload", logs[2]["msg"])
+}
+
+func TestLoggerConfigError(t *testing.T) {
+	assert := assert.New(t)
+	_, err := CreateAppFromMap(map[string]interface{}{"log_level": "verbose"}, map[Key]Extension{})
+	assert.ErrorContains(err, "invalid log_level")
+	_, err = CreateAppFromMap(map[string]interface{}{"log_format": "xml"}, map[Key]Extension{})
+	assert.EqualError(err, "invalid log_format: xml")
+}
@@ -0,0 +1,102 @@
+package gobay
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Registry returns the prometheus registry of the application. Extensions
+// register their collectors into it instead of the global registry, so that
+// several apps can live in one process.
+func (d *Application) Registry() *prometheus.Registry {
+	d.registryOnce.Do(func() {
+		d.registry = prometheus.NewRegistry()
+	})
+	return d.registry
+}
+
+// RegisterCollector registers c into the registry of app. If an equal
+// collector is registered already, e.g. the extension is initialized again,
+// the registered one is returned.
+func RegisterCollector[T prometheus.Collector](app *Application, c T) (T, error) {
+	if err := app.Registry().Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing, nil
+			}
+		}
+		return c, err
+	}
+	return c, nil
+}
+
+// MeterProvider returns the OpenTelemetry MeterProvider of the app. The
+// metrics recorded by its instruments are served by MetricsHandler, and are
+// pushed to the OTel collector too if OTEL_ENABLE is true. It returns a noop
+// provider before the app is initialized.
+func (d *Application) MeterProvider() metric.MeterProvider {
+	if d.meterProvider == nil {
+		return noop.NewMeterProvider()
+	}
+	return d.meterProvider
+}
+
+// Meter returns a meter of the app MeterProvider, name is usually the
+// import path of the extension, e.g.
+//
+//	counter, err := app.Meter("github.com/shanbay/gobay/extensions/cachext").Int64Counter("cache_request_counter")
+func (d *Application) Meter(name string) metric.Meter {
+	return d.MeterProvider().Meter(name)
+}
+
+// MetricsHandler serves the metrics of the app registry, together with the
+// metrics of the global registry, e.g. the go runtime and grpc_prometheus.
+func (d *Application) MetricsHandler() http.Handler {
+	gatherers := prometheus.Gatherers{prometheus.DefaultGatherer, d.Registry()}
+	return promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{})
+}
+
+// startMetricsServer serves /metrics, /healthz and /readyz on
+// metrics_listen_host:metrics_listen_port if metrics_listen_port is set
+func (d *Application) startMetricsServer() error {
+	config := d.Config()
+	port := config.GetInt("metrics_listen_port")
+	if port == 0 {
+		return nil
+	}
+	addr := net.JoinHostPort(config.GetString("metrics_listen_host"), strconv.Itoa(port))
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", d.MetricsHandler())
+	mux.Handle("/healthz", d.LivenessHandler())
+	mux.Handle("/readyz", d.ReadinessHandler())
+	d.metricsServer = &http.Server{Handler: mux}
+	go func(srv *http.Server) {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			d.Logger().Error("metrics server stopped", "error", err)
+		}
+	}(d.metricsServer)
+	d.Logger().Info("metrics server started", "addr", lis.Addr().String())
+	return nil
+}
+
+func (d *Application) stopMetricsServer(ctx context.Context) error {
+	if d.metricsServer == nil {
+		return nil
+	}
+	err := d.metricsServer.Shutdown(ctx)
+	d.metricsServer = nil
+	return err
+}
@@ -0,0 +1,106 @@
+package gobay
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func freePort(t *testing.T) int {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+func TestRegisterCollector(t *testing.T) {
+	assert := assert.New(t)
+	app, err := CreateAppFromMap(map[string]interface{}{}, map[Key]Extension{})
+	assert.Nil(err)
+	newCounter := func() prometheus.Counter {
+		return prometheus.NewCounter(prometheus.CounterOpts{Name: "test_counter", Help: "test"})
+	}
+	counter, err := RegisterCollector(app, newCounter())
+	assert.Nil(err)
+	again, err := RegisterCollector(app, newCounter())
+	assert.Nil(err)
+	assert.Same(counter, again)
+
+	// another app has its own registry
+	other, err := CreateAppFromMap(map[string]interface{}{}, map[Key]Extension{})
+	assert.Nil(err)
+	otherCounter, err := RegisterCollector(other, newCounter())
+	assert.Nil(err)
+	assert.NotSame(counter, otherCounter)
+
+	_, err = RegisterCollector(app, prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_counter", Help: "test"}))
+	assert.Error(err)
+}
+
+func TestMetricsServer(t *testing.T) {
+	assert := assert.New(t)
+	port := freePort(t)
+	app, err := CreateAppFromMap(map[string]interface{}{
+		"metrics_listen_host": "127.0.0.1",
+		"metrics_listen_port": port,
+	}, map[Key]Extension{})
+	assert.Nil(err)
+	counter, err := RegisterCollector(app, prometheus.NewCounter(prometheus.CounterOpts{Name: "test_served", Help: "test"}))
+	assert.Nil(err)
+	counter.Inc()
+
+	url := "http://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(port)) + "/metrics"
+	_, err = http.Get(url)
+	assert.Error(err, "not listening before Start")
+
+	assert.Nil(app.Start())
+	resp, err := http.Get(url)
+	assert.Nil(err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Nil(err)
+	assert.Contains(string(body), "test_served 1")
+	// the global registry is served too
+	assert.Contains(string(body), "go_goroutines")
+
+	assert.Nil(app.Close())
+	_, err = http.Get(url)
+	assert.Error(err)
+}
+
+func TestMeterProvider(t *testing.T) {
+	assert := assert.New(t)
+	app := &Application{configMap: map[string]interface{}{}}
+	// noop before Init
+	_, err := app.Meter("test").Int64Counter("test_noop")
+	assert.Nil(err)
+
+	assert.Nil(app.Init())
+	counter, err := app.Meter("test").Int64Counter("test_otel_counter")
+	assert.Nil(err)
+	counter.Add(context.Background(), 2, metric.WithAttributes(attribute.String("kind", "a")))
+	histogram, err := app.Meter("test").Float64Histogram("test_otel_duration", metric.WithUnit("s"))
+	assert.Nil(err)
+	histogram.Record(context.Background(), 0.1)
+
+	rec := httptest.NewRecorder()
+	app.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	assert.Contains(body, `test_otel_counter{kind="a"} 2`)
+	assert.Contains(body, `test_otel_duration_seconds_count 1`)
+	assert.NotContains(body, "otel_scope_info")
+	assert.NotContains(body, "target_info")
+
+	assert.Nil(app.Close())
+}
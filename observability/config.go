@@ -0,0 +1,204 @@
+package observability
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/contrib/propagators/autoprop"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// exporters of traces
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+	ExporterNone   = "none"
+)
+
+// protocols of the OTLP exporters
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+)
+
+// Config configures the OpenTelemetry traces and metrics. ConfigFromEnv
+// reads it from the standard OTEL_* env vars, and an app overrides it with
+// the otel_ prefixed config keys, e.g. otel_sampler for Sampler.
+type Config struct {
+	// Enable is OTEL_ENABLE
+	Enable bool `mapstructure:"enable"`
+	// Exporter of traces, otlp, stdout, file or none. OTEL_TRACES_EXPORTER,
+	// console is the same as stdout.
+	Exporter string `mapstructure:"exporter"`
+	// File the traces are appended to by the file exporter
+	File string `mapstructure:"file"`
+
+	// Protocol of the OTLP exporters, grpc or http/protobuf.
+	// OTEL_EXPORTER_OTLP_PROTOCOL
+	Protocol string `mapstructure:"protocol"`
+	// Endpoint of the collector, host:port or a URL. OTEL_SERVER_URL or
+	// OTEL_EXPORTER_OTLP_ENDPOINT
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS, nil means insecure unless a certificate or a
+	// client certificate is set. OTEL_EXPORTER_OTLP_INSECURE
+	Insecure *bool `mapstructure:"insecure"`
+	// Headers sent to the collector. OTEL_EXPORTER_OTLP_HEADERS
+	Headers map[string]string `mapstructure:"headers"`
+	// Certificate is the CA file to verify the collector.
+	// OTEL_EXPORTER_OTLP_CERTIFICATE
+	Certificate string `mapstructure:"certificate"`
+	// ClientCertificate and ClientKey are used for mTLS.
+	// OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE and OTEL_EXPORTER_OTLP_CLIENT_KEY
+	ClientCertificate string `mapstructure:"client_certificate"`
+	ClientKey         string `mapstructure:"client_key"`
+
+	// Sampler is always_on, always_off, traceidratio, parentbased_always_on,
+	// parentbased_always_off or parentbased_traceidratio.
+	// OTEL_TRACES_SAMPLER
+	Sampler string `mapstructure:"sampler"`
+	// SamplerArg is the ratio of the traceidratio samplers.
+	// OTEL_TRACES_SAMPLER_ARG
+	SamplerArg float64 `mapstructure:"sampler_arg"`
+	// Propagators are tracecontext, baggage, b3, b3multi, jaeger, xray,
+	// ottrace or none. OTEL_PROPAGATORS
+	Propagators []string `mapstructure:"propagators"`
+
+	// ServiceName is OTEL_SERVICE_NAME
+	ServiceName string `mapstructure:"service_name"`
+	// ServiceVersion defaults to the version of the main module
+	ServiceVersion string `mapstructure:"service_version"`
+	// Environment is the deployment.environment, the env of the app by default
+	Environment string `mapstructure:"environment"`
+	// ResourceAttributes are added to the resource, besides those in
+	// OTEL_RESOURCE_ATTRIBUTES
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes"`
+}
+
+// ConfigFromEnv returns the Config set by the OTEL_* env vars, with the
+// defaults of gobay: the OTLP exporter over insecure grpc, parent based
+// sampling of all traces and the W3C trace context propagator.
+func ConfigFromEnv() Config {
+	cfg := Config{
+		Enable:      os.Getenv("OTEL_ENABLE") == "true",
+		Exporter:    ExporterOTLP,
+		Protocol:    ProtocolGRPC,
+		Endpoint:    os.Getenv("OTEL_SERVER_URL"),
+		Sampler:     "parentbased_always_on",
+		SamplerArg:  1,
+		Propagators: []string{"tracecontext"},
+		ServiceName: os.Getenv("OTEL_SERVICE_NAME"),
+	}
+	if v := os.Getenv("OTEL_TRACES_EXPORTER"); v != "" {
+		cfg.Exporter = v
+	}
+	if v := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); v != "" {
+		cfg.Protocol = v
+	}
+	if v := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); v != "" && cfg.Endpoint == "" {
+		cfg.Endpoint = v
+	}
+	cfg.Certificate = os.Getenv("OTEL_EXPORTER_OTLP_CERTIFICATE")
+	cfg.ClientCertificate = os.Getenv("OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE")
+	cfg.ClientKey = os.Getenv("OTEL_EXPORTER_OTLP_CLIENT_KEY")
+	if v, err := strconv.ParseBool(os.Getenv("OTEL_EXPORTER_OTLP_INSECURE")); err == nil {
+		cfg.Insecure = &v
+	}
+	if v := os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"); v != "" {
+		cfg.Headers = parseKeyValues(v)
+	}
+	if v := os.Getenv("OTEL_TRACES_SAMPLER"); v != "" {
+		cfg.Sampler = v
+	}
+	if v, err := strconv.ParseFloat(os.Getenv("OTEL_TRACES_SAMPLER_ARG"), 64); err == nil {
+		cfg.SamplerArg = v
+	}
+	if v := os.Getenv("OTEL_PROPAGATORS"); v != "" {
+		cfg.Propagators = strings.Split(v, ",")
+	}
+	return cfg
+}
+
+// parseKeyValues parses k1=v1,k2=v2
+func parseKeyValues(s string) map[string]string {
+	res := make(map[string]string)
+	for _, kv := range strings.Split(s, ",") {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			res[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return res
+}
+
+func (c Config) sampler() (sdktrace.Sampler, error) {
+	switch c.Sampler {
+	case "always_on":
+		return sdktrace.AlwaysSample(), nil
+	case "always_off":
+		return sdktrace.NeverSample(), nil
+	case "traceidratio":
+		return sdktrace.TraceIDRatioBased(c.SamplerArg), nil
+	case "", "parentbased_always_on":
+		return sdktrace.ParentBased(sdktrace.AlwaysSample()), nil
+	case "parentbased_always_off":
+		return sdktrace.ParentBased(sdktrace.NeverSample()), nil
+	case "parentbased_traceidratio":
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SamplerArg)), nil
+	}
+	return nil, fmt.Errorf("unknown sampler %s", c.Sampler)
+}
+
+func (c Config) propagator() (propagation.TextMapPropagator, error) {
+	names := make([]string, 0, len(c.Propagators))
+	for _, name := range c.Propagators {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return propagation.TraceContext{}, nil
+	}
+	return autoprop.TextMapPropagator(names...)
+}
+
+// insecure returns whether TLS is disabled. The default depends on the
+// certificates, which may be set by the config of the app after the env vars.
+func (c Config) insecure() bool {
+	if c.Insecure != nil {
+		return *c.Insecure
+	}
+	return c.Certificate == "" && c.ClientCertificate == ""
+}
+
+// tlsConfig returns the TLS config to connect the collector, or nil if the
+// connection is insecure
+func (c Config) tlsConfig() (*tls.Config, error) {
+	if c.insecure() {
+		return nil, nil
+	}
+	cfg := &tls.Config{}
+	if c.Certificate != "" {
+		pem, err := os.ReadFile(c.Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("read certificate failed: %w", err)
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificate found in " + c.Certificate)
+		}
+	}
+	if c.ClientCertificate != "" || c.ClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertificate, c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate failed: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,111 @@
+package observability
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	assert := assert.New(t)
+	cfg := ConfigFromEnv()
+	assert.Equal(ExporterOTLP, cfg.Exporter)
+	assert.Equal(ProtocolGRPC, cfg.Protocol)
+	assert.Nil(cfg.Insecure)
+	assert.True(cfg.insecure())
+	assert.Equal([]string{"tracecontext"}, cfg.Propagators)
+
+	t.Setenv("OTEL_ENABLE", "true")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "https://collector:4318")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "api-key=abc, tenant=t1")
+	t.Setenv("OTEL_EXPORTER_OTLP_CERTIFICATE", "/etc/ca.pem")
+	t.Setenv("OTEL_TRACES_SAMPLER", "parentbased_traceidratio")
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.25")
+	t.Setenv("OTEL_PROPAGATORS", "b3,baggage")
+	cfg = ConfigFromEnv()
+	assert.True(cfg.Enable)
+	assert.Equal("https://collector:4318", cfg.Endpoint)
+	assert.Equal(ProtocolHTTP, cfg.Protocol)
+	assert.Equal(map[string]string{"api-key": "abc", "tenant": "t1"}, cfg.Headers)
+	assert.Nil(cfg.Insecure)
+	assert.False(cfg.insecure())
+
+	t.Setenv("OTEL_EXPORTER_OTLP_INSECURE", "true")
+	cfg = ConfigFromEnv()
+	assert.True(*cfg.Insecure)
+	assert.True(cfg.insecure())
+	tlsCfg, err := cfg.tlsConfig()
+	assert.Nil(err)
+	assert.Nil(tlsCfg)
+	assert.Equal("parentbased_traceidratio", cfg.Sampler)
+	assert.Equal(0.25, cfg.SamplerArg)
+	assert.Equal([]string{"b3", "baggage"}, cfg.Propagators)
+}
+
+func TestSampler(t *testing.T) {
+	assert := assert.New(t)
+	for name, desc := range map[string]string{
+		"always_on":                "AlwaysOnSampler",
+		"traceidratio":             "TraceIDRatioBased{0.5}",
+		"parentbased_traceidratio": "ParentBased{root:TraceIDRatioBased{0.5}",
+	} {
+		sampler, err := Config{Sampler: name, SamplerArg: 0.5}.sampler()
+		assert.Nil(err)
+		assert.Contains(sampler.Description(), desc)
+	}
+	_, err := Config{Sampler: "sometimes"}.sampler()
+	assert.EqualError(err, "unknown sampler sometimes")
+}
+
+func TestPropagator(t *testing.T) {
+	assert := assert.New(t)
+	p, err := Config{}.propagator()
+	assert.Nil(err)
+	assert.Equal(propagation.TraceContext{}, p)
+
+	p, err = Config{Propagators: []string{"tracecontext", " b3", "baggage", "jaeger"}}.propagator()
+	assert.Nil(err)
+	assert.Contains(p.Fields(), "traceparent")
+	assert.Contains(p.Fields(), "x-b3-traceid")
+	assert.Contains(p.Fields(), "baggage")
+	assert.Contains(p.Fields(), "uber-trace-id")
+
+	_, err = Config{Propagators: []string{"unknown"}}.propagator()
+	assert.NotNil(err)
+}
+
+func TestInitializeWithConfig(t *testing.T) {
+	assert := assert.New(t)
+	fn := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := InitializeWithConfig(Config{
+		Enable:             true,
+		Exporter:           ExporterFile,
+		File:               fn,
+		ServiceName:        "test",
+		Environment:        "testing",
+		ResourceAttributes: map[string]string{"team": "backend"},
+	})
+	assert.Nil(err)
+	assert.True(GetOtelEnable())
+
+	_, span := otel.Tracer("test").Start(context.Background(), "test-span")
+	span.End()
+	assert.Nil(shutdown(context.Background()))
+
+	data, err := os.ReadFile(fn)
+	assert.Nil(err)
+	assert.Contains(string(data), `"Name":"test-span"`)
+	assert.Contains(string(data), `"Value":"testing"`)
+	assert.Contains(string(data), `"Value":"backend"`)
+
+	_, err = InitializeWithConfig(Config{Enable: true, Exporter: ExporterFile})
+	assert.EqualError(err, "no file set for the file exporter")
+	_, err = InitializeWithConfig(Config{Enable: true, Exporter: "zipkin"})
+	assert.EqualError(err, "unknown trace exporter zipkin")
+}
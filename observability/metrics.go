@@ -0,0 +1,86 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"google.golang.org/grpc/credentials"
+)
+
+// NewMeterProvider creates a MeterProvider whose metrics are exported to
+// reg by the Prometheus exporter, and to the collector of cfg if cfg.Enable
+// is true and the exporter is otlp.
+//
+// Instrument names are kept as they are in Prometheus, e.g. a counter named
+// cache_request_counter is not renamed to cache_request_counter_total, so
+// that the metrics which used to be Prometheus collectors keep their names.
+func NewMeterProvider(reg prometheus.Registerer, cfg Config) (*sdkmetric.MeterProvider, error) {
+	promExporter, err := otelprom.New(
+		otelprom.WithRegisterer(reg),
+		otelprom.WithoutCounterSuffixes(),
+		otelprom.WithoutScopeInfo(),
+		otelprom.WithoutTargetInfo(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	opts := []sdkmetric.Option{sdkmetric.WithReader(promExporter)}
+	if cfg.Enable && (cfg.Exporter == "" || strings.ToLower(cfg.Exporter) == ExporterOTLP) {
+		ctx := context.Background()
+		otlpExporter, err := newOTLPMetricExporter(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		res, err := newResource(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts,
+			sdkmetric.WithResource(res),
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(otlpExporter)),
+		)
+	}
+	return sdkmetric.NewMeterProvider(opts...), nil
+}
+
+func newOTLPMetricExporter(ctx context.Context, cfg Config) (sdkmetric.Exporter, error) {
+	tlsCfg, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	switch cfg.Protocol {
+	case "", ProtocolGRPC:
+		opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithHeaders(cfg.Headers)}
+		if isURL(cfg.Endpoint) {
+			opts = append(opts, otlpmetricgrpc.WithEndpointURL(cfg.Endpoint))
+		} else if cfg.Endpoint != "" {
+			opts = append(opts, otlpmetricgrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(tlsCfg)))
+		} else if !strings.HasPrefix(cfg.Endpoint, "https://") {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+		return otlpmetricgrpc.New(ctx, opts...)
+	case ProtocolHTTP, "http":
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithHeaders(cfg.Headers)}
+		if isURL(cfg.Endpoint) {
+			opts = append(opts, otlpmetrichttp.WithEndpointURL(cfg.Endpoint))
+		} else if cfg.Endpoint != "" {
+			opts = append(opts, otlpmetrichttp.WithEndpoint(cfg.Endpoint))
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsCfg))
+		} else if !strings.HasPrefix(cfg.Endpoint, "https://") {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+	}
+	return nil, fmt.Errorf("unknown otlp protocol %s", cfg.Protocol)
+}
@@ -2,31 +2,54 @@ package observability
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"runtime/debug"
+	"strings"
 	"sync"
 	"sync/atomic"
 
 	"go.elastic.co/apm"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
-	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"google.golang.org/grpc/credentials"
 )
 
 var (
 	globalApmTracer  atomic.Value
 	setApmTracerOnce sync.Once
+	otelEnabled      atomic.Bool
 )
 
+// Initialize initializes the tracing with the Config from the OTEL_* env
+// vars, and exits if it fails.
 func Initialize() func(ctx context.Context) error {
+	shutdown, err := InitializeWithConfig(ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("failed to initialize tracing: %v\n", err)
+	}
+	return shutdown
+}
+
+// InitializeWithConfig initializes the APM tracer if APM_ENABLE is true,
+// and the OpenTelemetry tracer provider and propagator if cfg.Enable is
+// true. It returns the function to flush and shutdown them.
+func InitializeWithConfig(cfg Config) (func(ctx context.Context) error, error) {
 	initApm()
-	shutdownOtel := initOtel()
+	shutdownOtel, err := initOtel(cfg)
+	if err != nil {
+		return nil, err
+	}
 	return func(ctx context.Context) error {
 		if shutdownOtel != nil {
 			return shutdownOtel(ctx)
@@ -35,15 +58,17 @@ func Initialize() func(ctx context.Context) error {
 			tracer.Close()
 		}
 		return nil
-	}
+	}, nil
 }
 
 func GetApmEnable() bool {
 	return os.Getenv("APM_ENABLE") == "true"
 }
 
+// GetOtelEnable returns whether OTEL_ENABLE is true, or the OpenTelemetry
+// tracing is enabled by the config of the app
 func GetOtelEnable() bool {
-	return os.Getenv("OTEL_ENABLE") == "true"
+	return os.Getenv("OTEL_ENABLE") == "true" || otelEnabled.Load()
 }
 
 type tracerHolder struct {
@@ -68,40 +93,153 @@ func initApm() {
 	})
 }
 
-func initOtel() func(ctx context.Context) error {
-	if !GetOtelEnable() {
-		return nil
+func initOtel(cfg Config) (func(ctx context.Context) error, error) {
+	if !cfg.Enable {
+		return nil, nil
 	}
 
-	conn, err := initConn(os.Getenv("OTEL_SERVER_URL"))
+	ctx := context.Background()
+	res, err := newResource(ctx, cfg)
 	if err != nil {
-		log.Fatalf("failed to create gRPC connection: %v\n", err)
+		return nil, fmt.Errorf("create resource failed: %w", err)
 	}
-	ctx := context.Background()
-	res, _ := resource.New(ctx,
-		resource.WithAttributes(
-			attribute.KeyValue{
-				Key:   attribute.Key("service.name"),
-				Value: attribute.StringValue(os.Getenv("OTEL_SERVICE_NAME")),
-			}))
-	traceExporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithGRPCConn(conn))
+	sampler, err := cfg.sampler()
 	if err != nil {
-		log.Fatalf("failed to create trace exporter: %v\n", err)
+		return nil, err
 	}
-	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
-	tracerProvider := sdktrace.NewTracerProvider(
+	propagator, err := cfg.propagator()
+	if err != nil {
+		return nil, err
+	}
+	opts := []sdktrace.TracerProviderOption{
 		sdktrace.WithResource(res),
-		sdktrace.WithSpanProcessor(bsp),
-	)
+		sdktrace.WithSampler(sampler),
+	}
+	var closer io.Closer
+	switch strings.ToLower(cfg.Exporter) {
+	case "", ExporterOTLP:
+		exporter, err := newOTLPTraceExporter(ctx, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("create trace exporter failed: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case ExporterStdout, "console":
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("create trace exporter failed: %w", err)
+		}
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	case ExporterFile:
+		if cfg.File == "" {
+			return nil, errors.New("no file set for the file exporter")
+		}
+		f, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("open trace file failed: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("create trace exporter failed: %w", err)
+		}
+		closer = f
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	case ExporterNone:
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %s", cfg.Exporter)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(opts...)
 	otel.SetTracerProvider(tracerProvider)
-	otel.SetTextMapPropagator(propagation.TraceContext{})
-	return tracerProvider.Shutdown
+	otel.SetTextMapPropagator(propagator)
+	otelEnabled.Store(true)
+	return func(ctx context.Context) error {
+		err := tracerProvider.Shutdown(ctx)
+		if closer != nil {
+			if cerr := closer.Close(); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}, nil
 }
 
-func initConn(addr string) (*grpc.ClientConn, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func newOTLPTraceExporter(ctx context.Context, cfg Config) (*otlptrace.Exporter, error) {
+	tlsCfg, err := cfg.tlsConfig()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create gRPC connection to collector: %w", err)
+		return nil, err
+	}
+	switch cfg.Protocol {
+	case "", ProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithHeaders(cfg.Headers)}
+		if isURL(cfg.Endpoint) {
+			opts = append(opts, otlptracegrpc.WithEndpointURL(cfg.Endpoint))
+		} else if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsCfg)))
+		} else if !strings.HasPrefix(cfg.Endpoint, "https://") {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ProtocolHTTP, "http":
+		opts := []otlptracehttp.Option{otlptracehttp.WithHeaders(cfg.Headers)}
+		if isURL(cfg.Endpoint) {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		} else if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsCfg))
+		} else if !strings.HasPrefix(cfg.Endpoint, "https://") {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+	return nil, fmt.Errorf("unknown otlp protocol %s", cfg.Protocol)
+}
+
+func isURL(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://")
+}
+
+// newResource returns the resource of the service, shared by traces and
+// metrics. The attributes of cfg override those in OTEL_RESOURCE_ATTRIBUTES.
+func newResource(ctx context.Context, cfg Config) (*resource.Resource, error) {
+	attrs := []attribute.KeyValue{}
+	if cfg.ServiceName != "" {
+		attrs = append(attrs, semconv.ServiceName(cfg.ServiceName))
+	}
+	version := cfg.ServiceVersion
+	if version == "" {
+		if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "(devel)" {
+			version = info.Main.Version
+		}
+	}
+	if version != "" {
+		attrs = append(attrs, semconv.ServiceVersion(version))
+	}
+	if cfg.Environment != "" {
+		attrs = append(attrs, semconv.DeploymentEnvironment(cfg.Environment))
+	}
+	if v := os.Getenv("POD_NAME"); v != "" {
+		attrs = append(attrs, semconv.K8SPodName(v))
+	}
+	if v := os.Getenv("POD_NAMESPACE"); v != "" {
+		attrs = append(attrs, semconv.K8SNamespaceName(v))
+	}
+	for k, v := range cfg.ResourceAttributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithAttributes(attrs...),
+	)
+	if errors.Is(err, resource.ErrPartialResource) {
+		err = nil
 	}
-	return conn, err
+	return res, err
 }
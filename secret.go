@@ -0,0 +1,240 @@
+package gobay
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// RedactedValue replaces secret values when config is printed
+const RedactedValue = "******"
+
+// A SecretResolver resolves the reference in ${scheme:ref} to the secret value
+type SecretResolver func(ref string) (string, error)
+
+var (
+	secretResolvers = map[string]SecretResolver{
+		"file":   resolveFileSecret,
+		"base64": resolveBase64Secret,
+	}
+	secretResolversMu sync.RWMutex
+	secretRefPattern  = regexp.MustCompile(`\$\{([a-z][a-z0-9_]*):([^}]*)\}`)
+	secretSchemeRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+	// keys matching any of the patterns are redacted even if their values
+	// are not resolved from secrets, e.g. db_password, sentry_dsn,
+	// bus_broker_url and the headers sent to the OpenTelemetry collector,
+	// which usually carry an api key
+	redactPatterns = []*regexp.Regexp{
+		redactWordsPattern("password", "passwd", "secret", "token", "dsn", "url"),
+		regexp.MustCompile(`^otel_headers([._]|$)`),
+	}
+	redactPatternsMu sync.RWMutex
+)
+
+// redactWordsPattern matches keys containing one of words, delimited by _ or .
+func redactWordsPattern(words ...string) *regexp.Regexp {
+	return regexp.MustCompile(`(^|[._])(` + strings.Join(words, "|") + `)([._]|$)`)
+}
+
+// RegisterRedactPattern adds a regexp matching config keys whose values
+// must be redacted. The keys are in lower case, e.g. "cache_host".
+func RegisterRedactPattern(pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid redact pattern: %w", err)
+	}
+	redactPatternsMu.Lock()
+	defer redactPatternsMu.Unlock()
+	redactPatterns = append(redactPatterns, re)
+	return nil
+}
+
+func matchRedactPattern(key string) bool {
+	redactPatternsMu.RLock()
+	defer redactPatternsMu.RUnlock()
+	for _, re := range redactPatterns {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterSecretResolver registers a resolver for ${scheme:ref} in config
+// values, the resolved values are marked as secrets.
+func RegisterSecretResolver(scheme string, resolver SecretResolver) error {
+	if !secretSchemeRegex.MatchString(scheme) {
+		return fmt.Errorf("invalid secret scheme: %s", scheme)
+	}
+	secretResolversMu.Lock()
+	defer secretResolversMu.Unlock()
+	if _, exist := secretResolvers[scheme]; exist {
+		return errors.New("secret resolver already registered")
+	}
+	secretResolvers[scheme] = resolver
+	return nil
+}
+
+func getSecretResolver(scheme string) (SecretResolver, bool) {
+	secretResolversMu.RLock()
+	defer secretResolversMu.RUnlock()
+	resolver, ok := secretResolvers[scheme]
+	return resolver, ok
+}
+
+// ${file:/run/secrets/db_url}, trailing newline is trimmed
+func resolveFileSecret(ref string) (string, error) {
+	content, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// ${base64:ZGJfdXJs}
+func resolveBase64Secret(ref string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(ref)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+// expandEnv replaces ${VAR} and $VAR with environment variables like
+// os.ExpandEnv, but keeps ${scheme:ref} for the secret resolvers.
+func expandEnv(s string) string {
+	return os.Expand(s, func(name string) string {
+		if i := strings.Index(name, ":"); i > 0 && secretSchemeRegex.MatchString(name[:i]) {
+			return "${" + name + "}"
+		}
+		return os.Getenv(name)
+	})
+}
+
+// resolveSecrets resolves ${scheme:ref} in config values and returns the
+// keys whose values contain secrets.
+func resolveSecrets(config *viper.Viper) (map[string]bool, error) {
+	secrets := make(map[string]bool)
+	keys := config.AllKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, resolved, err := resolveSecretValue(config.Get(key))
+		if err != nil {
+			return nil, fmt.Errorf("resolve config %s failed: %w", key, err)
+		}
+		if resolved {
+			config.Set(key, value)
+			secrets[key] = true
+		}
+	}
+	return secrets, nil
+}
+
+func resolveSecretValue(value interface{}) (interface{}, bool, error) {
+	switch v := value.(type) {
+	case string:
+		return resolveSecretString(v)
+	case []interface{}:
+		var resolved bool
+		res := make([]interface{}, len(v))
+		for i, item := range v {
+			r, ok, err := resolveSecretValue(item)
+			if err != nil {
+				return nil, false, err
+			}
+			res[i], resolved = r, resolved || ok
+		}
+		return res, resolved, nil
+	case []string:
+		var resolved bool
+		res := make([]string, len(v))
+		for i, item := range v {
+			r, ok, err := resolveSecretString(item)
+			if err != nil {
+				return nil, false, err
+			}
+			res[i], resolved = r.(string), resolved || ok
+		}
+		return res, resolved, nil
+	}
+	return value, false, nil
+}
+
+func resolveSecretString(value string) (interface{}, bool, error) {
+	if !strings.Contains(value, "${") {
+		return value, false, nil
+	}
+	var resolveErr error
+	res := secretRefPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		if resolveErr != nil {
+			return ref
+		}
+		match := secretRefPattern.FindStringSubmatch(ref)
+		resolver, ok := getSecretResolver(match[1])
+		if !ok {
+			resolveErr = fmt.Errorf("unknown secret resolver: %s", match[1])
+			return ref
+		}
+		secret, err := resolver(match[2])
+		if err != nil {
+			resolveErr = fmt.Errorf("%s: %w", match[1], err)
+			return ref
+		}
+		return secret
+	})
+	if resolveErr != nil {
+		return nil, false, resolveErr
+	}
+	return res, res != value, nil
+}
+
+// IsSecret returns true if the value of key is resolved from a secret, or
+// key matches a redact pattern, e.g. admin_token and db_password
+func (d *Application) IsSecret(key string) bool {
+	key = strings.ToLower(key)
+	if matchRedactPattern(key) {
+		return true
+	}
+	d.configMu.RLock()
+	defer d.configMu.RUnlock()
+	for secret := range d.secrets {
+		if key == secret || strings.HasPrefix(secret, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact returns RedactedValue if key is a secret, otherwise value. Use it
+// when logging config values, e.g.
+//
+//	logger.Info("connect", "url", app.Redact("bus_broker_url", url))
+func (d *Application) Redact(key string, value interface{}) interface{} {
+	if d.IsSecret(key) {
+		return RedactedValue
+	}
+	return value
+}
+
+// RedactedConfig returns all config values keyed by the full key, with
+// secret values replaced by RedactedValue. Use it to print or log config.
+func (d *Application) RedactedConfig() map[string]interface{} {
+	config := d.Config()
+	res := make(map[string]interface{})
+	for _, key := range config.AllKeys() {
+		if d.IsSecret(key) {
+			res[key] = RedactedValue
+		} else {
+			res[key] = config.Get(key)
+		}
+	}
+	return res
+}
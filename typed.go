@@ -0,0 +1,81 @@
+package gobay
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Get returns the extension at key as T. T can be the type of the extension
+// itself, e.g. *redisext.RedisExt, or the type of its Object(), e.g. the
+// ent client of an EntExt.
+func Get[T any](app *Application, key Key) (T, error) {
+	var zero T
+	ext, ok := app.GetOK(key)
+	if !ok {
+		return zero, fmt.Errorf("extension %s not found", key)
+	}
+	if v, ok := asType[T](ext); ok {
+		return v, nil
+	}
+	return zero, fmt.Errorf("extension %s is %T, not %s", key, ext, typeName[T]())
+}
+
+// MustGet is like Get but panics if the extension is not found or is not T
+func MustGet[T any](app *Application, key Key) T {
+	v, err := Get[T](app, key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// GetByType returns the only extension which is T, see Get for the types
+// matched. It fails if there is no such extension or more than one.
+func GetByType[T any](app *Application) (T, error) {
+	var zero T
+	keys := make([]string, 0, len(app.extensions))
+	for key := range app.extensions {
+		keys = append(keys, string(key))
+	}
+	sort.Strings(keys)
+
+	var found []string
+	var result T
+	for _, key := range keys {
+		if v, ok := asType[T](app.extensions[Key(key)]); ok {
+			found = append(found, key)
+			result = v
+		}
+	}
+	switch len(found) {
+	case 0:
+		return zero, fmt.Errorf("no extension of type %s", typeName[T]())
+	case 1:
+		return result, nil
+	default:
+		return zero, fmt.Errorf("multiple extensions of type %s: %s", typeName[T](), strings.Join(found, ", "))
+	}
+}
+
+// MustGetByType is like GetByType but panics on error
+func MustGetByType[T any](app *Application) T {
+	v, err := GetByType[T](app)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func asType[T any](ext Extension) (T, bool) {
+	if v, ok := ext.(T); ok {
+		return v, true
+	}
+	v, ok := ext.Object().(T)
+	return v, ok
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,60 @@
+package gobay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type objectExtension struct {
+	orderExtension
+	client *strings.Builder
+}
+
+func (e *objectExtension) Object() interface{} { return e.client }
+
+func TestGet(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	order := &orderExtension{key: "order", events: &events}
+	object := &objectExtension{orderExtension{key: "object", events: &events}, &strings.Builder{}}
+	app, err := CreateAppFromMap(map[string]interface{}{}, map[Key]Extension{"order": order, "object": object})
+	assert.Nil(err)
+
+	got, err := Get[*orderExtension](app, "order")
+	assert.Nil(err)
+	assert.Same(order, got)
+	// match the Object() of the extension
+	client, err := Get[*strings.Builder](app, "object")
+	assert.Nil(err)
+	assert.Same(object.client, client)
+	assert.Same(object, MustGet[*objectExtension](app, "object"))
+
+	_, err = Get[*orderExtension](app, "missing")
+	assert.EqualError(err, "extension missing not found")
+	_, err = Get[*strings.Builder](app, "order")
+	assert.EqualError(err, "extension order is *gobay.orderExtension, not *strings.Builder")
+	assert.Panics(func() { MustGet[*objectExtension](app, "order") })
+}
+
+func TestGetByType(t *testing.T) {
+	assert := assert.New(t)
+	var events []string
+	first := &orderExtension{key: "first", events: &events}
+	second := &orderExtension{key: "second", events: &events}
+	object := &objectExtension{orderExtension{key: "object", events: &events}, &strings.Builder{}}
+	app, err := CreateAppFromMap(map[string]interface{}{}, map[Key]Extension{"first": first, "second": second, "object": object})
+	assert.Nil(err)
+
+	client, err := GetByType[*strings.Builder](app)
+	assert.Nil(err)
+	assert.Same(object.client, client)
+	assert.Same(object, MustGetByType[*objectExtension](app))
+
+	_, err = GetByType[*orderExtension](app)
+	assert.EqualError(err, "multiple extensions of type *gobay.orderExtension: first, second")
+	_, err = GetByType[*strings.Reader](app)
+	assert.EqualError(err, "no extension of type *strings.Reader")
+	assert.Panics(func() { MustGetByType[*strings.Reader](app) })
+}
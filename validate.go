@@ -0,0 +1,248 @@
+package gobay
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// Configurable is implemented by extensions which declare their config.
+// ConfigSchema returns the config prefix of the extension, usually its NS,
+// and a pointer to a struct describing the config under the prefix.
+//
+// The key of a field is its `mapstructure` tag or its name in lower case.
+// A field can have a `default` tag, and `validate:"required"` if it must be
+// set. Application validates the schemas before initializing extensions and
+// reports unknown keys, missing required keys and type errors together.
+type Configurable interface {
+	ConfigSchema() (prefix string, schema interface{})
+}
+
+// ConfigSchemaExtender is implemented by Configurable extensions whose
+// plugins read their own keys under the prefix, e.g. the backends of
+// CacheExt. ExtraConfigSchemas returns the schemas of the plugins selected
+// by config, the config under the prefix with the prefix trimmed. Their keys
+// are validated together with the keys of ConfigSchema.
+type ConfigSchemaExtender interface {
+	ExtraConfigSchemas(config *viper.Viper) []interface{}
+}
+
+type configField struct {
+	key        string
+	defaultVal string
+	hasDefault bool
+	required   bool
+}
+
+type configSchema struct {
+	key     Key
+	prefix  string
+	schemas []interface{}
+	fields  map[string]configField
+}
+
+// ValidateConfig loads the config of rootPath and env, and validates it with
+// the schemas of exts without initializing them. It is useful in CI.
+func ValidateConfig(rootPath string, env string, exts map[Key]Extension, opts ...Option) error {
+	if rootPath == "" || env == "" {
+		return fmt.Errorf("lack of rootPath or env")
+	}
+	app := &Application{rootPath: rootPath, env: env, extensions: exts}
+	for _, opt := range opts {
+		opt(app)
+	}
+	config, _, sources, err := app.loadConfig()
+	if err != nil {
+		return err
+	}
+	return app.validateConfig(config, sources)
+}
+
+// validateConfig applies the defaults declared by the schemas to config and
+// validates config with the schemas. The defaults are recorded in sources.
+func (d *Application) validateConfig(config *viper.Viper, sources configSources) error {
+	schemas, err := d.configSchemas(config)
+	if err != nil {
+		return err
+	}
+	prefixes := d.configPrefixes(schemas)
+	var allerr error
+	for _, schema := range schemas {
+		for _, field := range schema.fields {
+			if field.hasDefault {
+				config.SetDefault(schema.prefix+field.key, field.defaultVal)
+				sources.setDefault(schema.prefix+field.key, ConfigSource{Kind: ConfigFromDefault, Origin: string(schema.key)})
+			}
+		}
+	}
+	for _, schema := range schemas {
+		sub := GetConfigByPrefix(config, schema.prefix, true)
+		settings := sub.AllSettings()
+		keys := make([]string, 0, len(settings))
+		for k := range settings {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if _, ok := schema.fields[k]; ok || ownedByOther(schema.prefix, prefixes, schema.prefix+k) {
+				continue
+			}
+			allerr = multierror.Append(allerr, fmt.Errorf("%s: unknown config %s%s", schema.key, schema.prefix, k))
+		}
+		for _, field := range sortedFields(schema.fields) {
+			if field.required && !sub.IsSet(field.key) {
+				allerr = multierror.Append(allerr, fmt.Errorf("%s: missing required config %s%s", schema.key, schema.prefix, field.key))
+			}
+		}
+		for _, s := range schema.schemas {
+			if err := decodeConfig(settings, s); err != nil {
+				var decodeErr *mapstructure.Error
+				if errors.As(err, &decodeErr) {
+					for _, e := range decodeErr.Errors {
+						allerr = multierror.Append(allerr, fmt.Errorf("%s: invalid config under %s: %s", schema.key, schema.prefix, e))
+					}
+				} else {
+					allerr = multierror.Append(allerr, fmt.Errorf("%s: %w", schema.key, err))
+				}
+			}
+		}
+	}
+	return allerr
+}
+
+func (d *Application) configSchemas(config *viper.Viper) ([]*configSchema, error) {
+	keys := make([]Key, 0, len(d.extensions))
+	for key := range d.extensions {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	var schemas []*configSchema
+	for _, key := range keys {
+		configurable, ok := d.extensions[key].(Configurable)
+		if !ok {
+			continue
+		}
+		prefix, schema := configurable.ConfigSchema()
+		if prefix == "" {
+			// the extension reports the lack of NS itself
+			continue
+		}
+		all := []interface{}{schema}
+		if extender, ok := configurable.(ConfigSchemaExtender); ok {
+			all = append(all, extender.ExtraConfigSchemas(GetConfigByPrefix(config, prefix, true))...)
+		}
+		fields := make(map[string]configField)
+		for _, s := range all {
+			f, err := parseConfigSchema(s)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			for k, field := range f {
+				fields[k] = field
+			}
+		}
+		schemas = append(schemas, &configSchema{key: key, prefix: prefix, schemas: all, fields: fields})
+	}
+	return schemas, nil
+}
+
+// configPrefixes returns the config prefixes of all extensions, which are
+// the prefixes of the schemas, and the NS of the extensions without schema
+func (d *Application) configPrefixes(schemas []*configSchema) []string {
+	var prefixes []string
+	for _, schema := range schemas {
+		prefixes = append(prefixes, schema.prefix)
+	}
+	for _, ext := range d.extensions {
+		if _, ok := ext.(Configurable); ok {
+			continue
+		}
+		v := reflect.Indirect(reflect.ValueOf(ext))
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		if ns := v.FieldByName("NS"); ns.IsValid() && ns.Kind() == reflect.String && ns.String() != "" {
+			prefixes = append(prefixes, ns.String())
+		}
+	}
+	return prefixes
+}
+
+func parseConfigSchema(schema interface{}) (map[string]configField, error) {
+	t := reflect.TypeOf(schema)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("config schema must be a pointer to struct")
+	}
+	t = t.Elem()
+	fields := make(map[string]configField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		field := configField{key: strings.ToLower(name)}
+		field.defaultVal, field.hasDefault = f.Tag.Lookup("default")
+		for _, rule := range strings.Split(f.Tag.Get("validate"), ",") {
+			switch rule {
+			case "":
+			case "required":
+				field.required = true
+			default:
+				return nil, fmt.Errorf("unknown validate rule %s of field %s", rule, f.Name)
+			}
+		}
+		fields[field.key] = field
+	}
+	return fields, nil
+}
+
+// ownedByOther returns true if key belongs to another extension with a
+// longer prefix, e.g. db_replica_url belongs to db_replica_ instead of db_
+func ownedByOther(prefix string, prefixes []string, key string) bool {
+	for _, other := range prefixes {
+		if len(other) > len(prefix) && strings.HasPrefix(other, prefix) && strings.HasPrefix(key, other) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedFields(fields map[string]configField) []configField {
+	res := make([]configField, 0, len(fields))
+	for _, field := range fields {
+		res = append(res, field)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].key < res[j].key })
+	return res
+}
+
+// decodeConfig decodes settings into a new value of schema's type, the same
+// way as viper.Unmarshal
+func decodeConfig(settings map[string]interface{}, schema interface{}) error {
+	result := reflect.New(reflect.TypeOf(schema).Elem()).Interface()
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           result,
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(settings)
+}
@@ -0,0 +1,123 @@
+package gobay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type schemaExtension struct {
+	orderExtension
+	prefix string
+}
+
+type testSchema struct {
+	Host    string        `validate:"required"`
+	Port    int           `mapstructure:"port" validate:"required"`
+	Timeout time.Duration `default:"3s"`
+	Tags    []string
+}
+
+func (e *schemaExtension) ConfigSchema() (string, interface{}) {
+	return e.prefix, &testSchema{}
+}
+
+func TestValidateConfig(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	var events []string
+	exts := map[Key]Extension{
+		"db":        &schemaExtension{orderExtension{key: "db", events: &events}, "db_"},
+		"dbreplica": &schemaExtension{orderExtension{key: "dbreplica", events: &events}, "db_replica_"},
+	}
+
+	writeConfig(t, dir, `testing:
+  db_host: 127.0.0.1
+  db_port: 3306
+  db_replica_host: 127.0.0.2
+  db_replica_port: 3307
+  db_replica_timeout: 1s
+  other_key: 1
+`)
+	assert.Nil(ValidateConfig(dir, "testing", exts))
+	app, err := CreateApp(dir, "testing", exts)
+	assert.Nil(err)
+	assert.Equal(3*time.Second, app.Config().GetDuration("db_timeout"))
+	assert.Equal(time.Second, app.Config().GetDuration("db_replica_timeout"))
+	events = nil
+
+	writeConfig(t, dir, `testing:
+  db_hots: 127.0.0.1
+  db_port: abc
+  db_replica_host: 127.0.0.2
+  db_replica_port: 3307
+  db_replica_timeout: 1 second
+`)
+	err = ValidateConfig(dir, "testing", exts)
+	assert.NotNil(err)
+	errs := err.(*multierror.Error).Errors
+	assert.Len(errs, 4)
+	assert.EqualError(errs[0], "db: unknown config db_hots")
+	assert.EqualError(errs[1], "db: missing required config db_host")
+	assert.Contains(errs[2].Error(), "db: invalid config under db_: cannot parse 'port' as int")
+	assert.Contains(errs[3].Error(), "dbreplica: invalid config under db_replica_: error decoding 'Timeout'")
+
+	app, err = CreateApp(dir, "testing", exts)
+	assert.Nil(app)
+	assert.NotNil(err)
+	assert.Empty(events)
+}
+
+type nsExtension struct {
+	orderExtension
+	NS string
+}
+
+type extendedSchemaExtension struct {
+	schemaExtension
+}
+
+type pluginSchema struct {
+	Plugin string `mapstructure:"plugin"`
+	Size   int    `mapstructure:"plugin_size" default:"10"`
+}
+
+func (e *extendedSchemaExtension) ExtraConfigSchemas(config *viper.Viper) []interface{} {
+	if config.GetString("plugin") == "" {
+		return nil
+	}
+	return []interface{}{&pluginSchema{}}
+}
+
+func TestValidateConfig_Extended(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	var events []string
+	exts := map[Key]Extension{
+		"db":        &extendedSchemaExtension{schemaExtension{orderExtension{key: "db", events: &events}, "db_"}},
+		"dbreplica": &nsExtension{orderExtension{key: "dbreplica", events: &events}, "db_replica_"},
+	}
+
+	writeConfig(t, dir, `testing:
+  db_host: 127.0.0.1
+  db_port: 3306
+  db_plugin: lru
+  db_replica_url: mysql://replica
+`)
+	assert.Nil(ValidateConfig(dir, "testing", exts))
+	app, err := CreateApp(dir, "testing", exts)
+	assert.Nil(err)
+	assert.Equal(10, app.Config().GetInt("db_plugin_size"))
+
+	writeConfig(t, dir, `testing:
+  db_host: 127.0.0.1
+  db_port: 3306
+  db_plugin_size: 20
+`)
+	err = ValidateConfig(dir, "testing", exts)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "db: unknown config db_plugin_size")
+}